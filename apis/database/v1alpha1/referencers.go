@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errResolveCockroachCluster  = "cannot resolve referenced CockroachCluster"
+	errResolveCockroachDatabase = "cannot resolve referenced CockroachDatabase"
+	errResolveCockroachUser     = "cannot resolve referenced CockroachUser"
+	errResolveYugabyteCluster   = "cannot resolve referenced YugabyteCluster"
+	errResolveYSQLRole          = "cannot resolve referenced YSQLRole"
+	errResolveYCQLKeyspace      = "cannot resolve referenced YCQLKeyspace"
+)
+
+// ResourceName extracts the name of a resolved managed resource.
+func ResourceName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		return mg.GetName()
+	}
+}
+
+// CockroachDatabaseName extracts the database name of a resolved
+// CockroachDatabase.
+func CockroachDatabaseName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*CockroachDatabase)
+		if !ok {
+			return ""
+		}
+		return d.Spec.CockroachDatabaseParameters.Name
+	}
+}
+
+// CockroachUserName extracts the user name of a resolved CockroachUser.
+func CockroachUserName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		u, ok := mg.(*CockroachUser)
+		if !ok {
+			return ""
+		}
+		return u.Spec.CockroachUserParameters.Name
+	}
+}
+
+// YSQLRoleName extracts the role name of a resolved YSQLRole.
+func YSQLRoleName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*YSQLRole)
+		if !ok {
+			return ""
+		}
+		return r.Spec.YSQLRoleParameters.Name
+	}
+}
+
+// YCQLKeyspaceName extracts the keyspace name of a resolved YCQLKeyspace.
+func YCQLKeyspaceName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		k, ok := mg.(*YCQLKeyspace)
+		if !ok {
+			return ""
+		}
+		return k.Spec.YCQLKeyspaceParameters.Name
+	}
+}
+
+func resolve(ctx context.Context, r *reference.APIResolver, to reference.To, ex reference.ExtractValueFn, v *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *v,
+		Reference:    *ref,
+		Selector:     sel,
+		To:           to,
+		Extract:      ex,
+	})
+	if err != nil {
+		return err
+	}
+	*v = rsp.ResolvedValue
+	*ref = rsp.ResolvedReference
+	return nil
+}
+
+func (r *CockroachClusterReference) resolve(ctx context.Context, res *reference.APIResolver) error {
+	to := reference.To{Managed: &CockroachCluster{}, List: &CockroachClusterList{}}
+	err := resolve(ctx, res, to, ResourceName(), &r.Cluster, &r.ClusterRef, r.ClusterSelector)
+	return errors.Wrap(err, errResolveCockroachCluster)
+}
+
+// ResolveReferences of this CockroachDatabase.
+func (mg *CockroachDatabase) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.CockroachDatabaseParameters.CockroachClusterReference.resolve(ctx, reference.NewAPIResolver(c, mg))
+}
+
+// ResolveReferences of this CockroachUser.
+func (mg *CockroachUser) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.CockroachUserParameters.CockroachClusterReference.resolve(ctx, reference.NewAPIResolver(c, mg))
+}
+
+// ResolveReferences of this CockroachGrant.
+func (mg *CockroachGrant) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.CockroachGrantParameters
+
+	if err := p.CockroachClusterReference.resolve(ctx, r); err != nil {
+		return err
+	}
+
+	to := reference.To{Managed: &CockroachDatabase{}, List: &CockroachDatabaseList{}}
+	if err := resolve(ctx, r, to, CockroachDatabaseName(), &p.Database, &p.DatabaseRef, p.DatabaseSelector); err != nil {
+		return errors.Wrap(err, errResolveCockroachDatabase)
+	}
+
+	to = reference.To{Managed: &CockroachUser{}, List: &CockroachUserList{}}
+	err := resolve(ctx, r, to, CockroachUserName(), &p.User, &p.UserRef, p.UserSelector)
+	return errors.Wrap(err, errResolveCockroachUser)
+}
+
+// ResolveReferences of this CockroachClusterSetting.
+func (mg *CockroachClusterSetting) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.CockroachClusterSettingParameters.CockroachClusterReference.resolve(ctx, reference.NewAPIResolver(c, mg))
+}
+
+// ResolveReferences of this CockroachZoneConfig.
+func (mg *CockroachZoneConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.CockroachZoneConfigParameters
+
+	if err := p.CockroachClusterReference.resolve(ctx, r); err != nil {
+		return err
+	}
+
+	to := reference.To{Managed: &CockroachDatabase{}, List: &CockroachDatabaseList{}}
+	err := resolve(ctx, r, to, CockroachDatabaseName(), &p.Database, &p.DatabaseRef, p.DatabaseSelector)
+	return errors.Wrap(err, errResolveCockroachDatabase)
+}
+
+func (r *YugabyteClusterReference) resolve(ctx context.Context, res *reference.APIResolver) error {
+	to := reference.To{Managed: &YugabyteCluster{}, List: &YugabyteClusterList{}}
+	err := resolve(ctx, res, to, ResourceName(), &r.Cluster, &r.ClusterRef, r.ClusterSelector)
+	return errors.Wrap(err, errResolveYugabyteCluster)
+}
+
+// ResolveReferences of this YSQLDatabase.
+func (mg *YSQLDatabase) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.YSQLDatabaseParameters
+
+	if err := p.YugabyteClusterReference.resolve(ctx, r); err != nil {
+		return err
+	}
+
+	to := reference.To{Managed: &YSQLRole{}, List: &YSQLRoleList{}}
+	err := resolve(ctx, r, to, YSQLRoleName(), &p.Owner, &p.OwnerRef, p.OwnerSelector)
+	return errors.Wrap(err, errResolveYSQLRole)
+}
+
+// ResolveReferences of this YSQLRole.
+func (mg *YSQLRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.YSQLRoleParameters.YugabyteClusterReference.resolve(ctx, reference.NewAPIResolver(c, mg))
+}
+
+// ResolveReferences of this YCQLKeyspace.
+func (mg *YCQLKeyspace) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.YCQLKeyspaceParameters.YugabyteClusterReference.resolve(ctx, reference.NewAPIResolver(c, mg))
+}
+
+// ResolveReferences of this YCQLRole.
+func (mg *YCQLRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.YCQLRoleParameters
+
+	if err := p.YugabyteClusterReference.resolve(ctx, r); err != nil {
+		return err
+	}
+
+	to := reference.To{Managed: &YCQLKeyspace{}, List: &YCQLKeyspaceList{}}
+	for i := range p.Grants {
+		g := &p.Grants[i]
+		if err := resolve(ctx, r, to, YCQLKeyspaceName(), &g.Keyspace, &g.KeyspaceRef, g.KeyspaceSelector); err != nil {
+			return errors.Wrap(err, errResolveYCQLKeyspace)
+		}
+	}
+
+	return nil
+}
@@ -51,7 +51,87 @@ var (
 	CockroachClusterGroupVersionKind = SchemeGroupVersion.WithKind(CockroachClusterKind)
 )
 
+// CassandraCluster type metadata.
+var (
+	CassandraClusterKind             = reflect.TypeOf(CassandraCluster{}).Name()
+	CassandraClusterKindAPIVersion   = CassandraClusterKind + "." + SchemeGroupVersion.String()
+	CassandraClusterGroupVersionKind = SchemeGroupVersion.WithKind(CassandraClusterKind)
+)
+
+// CockroachDatabase type metadata.
+var (
+	CockroachDatabaseKind             = reflect.TypeOf(CockroachDatabase{}).Name()
+	CockroachDatabaseKindAPIVersion   = CockroachDatabaseKind + "." + SchemeGroupVersion.String()
+	CockroachDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(CockroachDatabaseKind)
+)
+
+// CockroachUser type metadata.
+var (
+	CockroachUserKind             = reflect.TypeOf(CockroachUser{}).Name()
+	CockroachUserKindAPIVersion   = CockroachUserKind + "." + SchemeGroupVersion.String()
+	CockroachUserGroupVersionKind = SchemeGroupVersion.WithKind(CockroachUserKind)
+)
+
+// CockroachGrant type metadata.
+var (
+	CockroachGrantKind             = reflect.TypeOf(CockroachGrant{}).Name()
+	CockroachGrantKindAPIVersion   = CockroachGrantKind + "." + SchemeGroupVersion.String()
+	CockroachGrantGroupVersionKind = SchemeGroupVersion.WithKind(CockroachGrantKind)
+)
+
+// CockroachClusterSetting type metadata.
+var (
+	CockroachClusterSettingKind             = reflect.TypeOf(CockroachClusterSetting{}).Name()
+	CockroachClusterSettingKindAPIVersion   = CockroachClusterSettingKind + "." + SchemeGroupVersion.String()
+	CockroachClusterSettingGroupVersionKind = SchemeGroupVersion.WithKind(CockroachClusterSettingKind)
+)
+
+// CockroachZoneConfig type metadata.
+var (
+	CockroachZoneConfigKind             = reflect.TypeOf(CockroachZoneConfig{}).Name()
+	CockroachZoneConfigKindAPIVersion   = CockroachZoneConfigKind + "." + SchemeGroupVersion.String()
+	CockroachZoneConfigGroupVersionKind = SchemeGroupVersion.WithKind(CockroachZoneConfigKind)
+)
+
+// YSQLDatabase type metadata.
+var (
+	YSQLDatabaseKind             = reflect.TypeOf(YSQLDatabase{}).Name()
+	YSQLDatabaseKindAPIVersion   = YSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	YSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(YSQLDatabaseKind)
+)
+
+// YSQLRole type metadata.
+var (
+	YSQLRoleKind             = reflect.TypeOf(YSQLRole{}).Name()
+	YSQLRoleKindAPIVersion   = YSQLRoleKind + "." + SchemeGroupVersion.String()
+	YSQLRoleGroupVersionKind = SchemeGroupVersion.WithKind(YSQLRoleKind)
+)
+
+// YCQLKeyspace type metadata.
+var (
+	YCQLKeyspaceKind             = reflect.TypeOf(YCQLKeyspace{}).Name()
+	YCQLKeyspaceKindAPIVersion   = YCQLKeyspaceKind + "." + SchemeGroupVersion.String()
+	YCQLKeyspaceGroupVersionKind = SchemeGroupVersion.WithKind(YCQLKeyspaceKind)
+)
+
+// YCQLRole type metadata.
+var (
+	YCQLRoleKind             = reflect.TypeOf(YCQLRole{}).Name()
+	YCQLRoleKindAPIVersion   = YCQLRoleKind + "." + SchemeGroupVersion.String()
+	YCQLRoleGroupVersionKind = SchemeGroupVersion.WithKind(YCQLRoleKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&YugabyteCluster{}, &YugabyteClusterList{})
 	SchemeBuilder.Register(&CockroachCluster{}, &CockroachClusterList{})
+	SchemeBuilder.Register(&CassandraCluster{}, &CassandraClusterList{})
+	SchemeBuilder.Register(&CockroachDatabase{}, &CockroachDatabaseList{})
+	SchemeBuilder.Register(&CockroachUser{}, &CockroachUserList{})
+	SchemeBuilder.Register(&CockroachGrant{}, &CockroachGrantList{})
+	SchemeBuilder.Register(&CockroachClusterSetting{}, &CockroachClusterSettingList{})
+	SchemeBuilder.Register(&CockroachZoneConfig{}, &CockroachZoneConfigList{})
+	SchemeBuilder.Register(&YSQLDatabase{}, &YSQLDatabaseList{})
+	SchemeBuilder.Register(&YSQLRole{}, &YSQLRoleList{})
+	SchemeBuilder.Register(&YCQLKeyspace{}, &YCQLKeyspaceList{})
+	SchemeBuilder.Register(&YCQLRole{}, &YCQLRoleList{})
 }
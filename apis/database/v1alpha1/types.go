@@ -27,8 +27,11 @@ import (
 
 // ServerSpec describes server related settings of the cluster
 type ServerSpec struct {
-	Replicas            int32                        `json:"replicas,omitempty"`
-	Network             NetworkSpec                  `json:"network,omitempty"`
+	Replicas int32       `json:"replicas,omitempty"`
+	Network  NetworkSpec `json:"network,omitempty"`
+	// VolumeClaimTemplate of the servers. Its requested storage may be
+	// increased, but not decreased, once the cluster exists; no other change
+	// to it affects existing servers.
 	VolumeClaimTemplate corev1.PersistentVolumeClaim `json:"volumeClaimTemplate,omitempty"`
 }
 
@@ -51,8 +54,12 @@ type YugabyteClusterParameters struct {
 	Name        string               `json:"name"`
 	Namespace   string               `json:"namespace"`
 	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
-	Master      ServerSpec           `json:"master"`
-	TServer     ServerSpec           `json:"tserver"`
+	// Master replicas may only be changed to an odd number that keeps a
+	// majority of the current masters.
+	Master ServerSpec `json:"master"`
+	// TServer replicas are removed only once their data has been moved to
+	// the remaining tservers.
+	TServer ServerSpec `json:"tserver"`
 }
 
 // A YugabyteClusterSpec defines the desired state of a YugabyteCluster.
@@ -61,9 +68,25 @@ type YugabyteClusterSpec struct {
 	YugabyteClusterParameters `json:"forProvider"`
 }
 
+// A YugabyteClusterObservation reflects the observed state of a
+// YugabyteCluster.
+type YugabyteClusterObservation struct {
+	// BlacklistedTServers are the addresses of tservers that were blacklisted
+	// so that their data was moved off them before they were removed.
+	BlacklistedTServers []string `json:"blacklistedTServers,omitempty"`
+
+	// DataMovePercentComplete is the percentage of the data on blacklisted
+	// tservers that has been moved off them.
+	DataMovePercentComplete string `json:"dataMovePercentComplete,omitempty"`
+
+	// VolumeClaims of the masters and tservers.
+	VolumeClaims []v1alpha1.VolumeClaimObservation `json:"volumeClaims,omitempty"`
+}
+
 // A YugabyteClusterStatus defines the current state of a YugabyteCluster.
 type YugabyteClusterStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          YugabyteClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -92,17 +115,43 @@ type YugabyteClusterList struct {
 	Items           []YugabyteCluster `json:"items"`
 }
 
+// A CockroachRestoreSource describes a backup from which the databases of a
+// new CockroachCluster are restored.
+type CockroachRestoreSource struct {
+	// Databases to restore from the backup.
+	// +kubebuilder:validation:MinItems=1
+	Databases []string `json:"databases"`
+
+	// URI of the backup, for example s3://bucket/path.
+	URI string `json:"uri"`
+
+	// CredentialsSecretRef references a secret whose keys and values are
+	// added to the URI as query parameters, for example AWS_ACCESS_KEY_ID and
+	// AWS_SECRET_ACCESS_KEY.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretReference `json:"credentialsSecretRef,omitempty"`
+}
+
 // A CockroachClusterParameters defines the desired state of a CockroachCluster.
 type CockroachClusterParameters struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
 	// The annotations-related configuration to add/set on each Pod related object.
-	Annotations         v1alpha1.Annotations      `json:"annotations,omitempty"`
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// Storage of the nodes. The storage requested by its volume claim
+	// templates may be increased, but not decreased, once the cluster exists.
 	Storage             v1alpha1.StorageScopeSpec `json:"scope,omitempty"`
 	Network             NetworkSpec               `json:"network,omitempty"`
 	Secure              bool                      `json:"secure,omitempty"`
 	CachePercent        int                       `json:"cachePercent,omitempty"`
 	MaxSQLMemoryPercent int                       `json:"maxSQLMemoryPercent,omitempty"`
+
+	// RestoreFrom is a backup from which databases are restored once the
+	// cluster first accepts SQL connections. The cluster does not become
+	// available until the restore has succeeded. Restoring requires an
+	// enterprise license.
+	// +optional
+	RestoreFrom *CockroachRestoreSource `json:"restoreFrom,omitempty"`
 }
 
 // A CockroachClusterSpec defines the desired state of a CockroachCluster.
@@ -111,9 +160,37 @@ type CockroachClusterSpec struct {
 	CockroachClusterParameters `json:"forProvider"`
 }
 
+// A CockroachRestoreObservation reflects the observed state of the job that
+// restores a CockroachCluster from a backup.
+type CockroachRestoreObservation struct {
+	// JobID is the ID of the restore job.
+	JobID int64 `json:"jobID"`
+
+	// Status of the restore job, for example running or succeeded.
+	Status string `json:"status"`
+
+	// FractionCompleted is the fraction of the restore job that has
+	// completed, between 0 and 1.
+	FractionCompleted string `json:"fractionCompleted,omitempty"`
+
+	// Error that caused the restore job to fail.
+	Error string `json:"error,omitempty"`
+}
+
+// A CockroachClusterObservation reflects the observed state of a
+// CockroachCluster.
+type CockroachClusterObservation struct {
+	// Restore is the job that restores the cluster from a backup.
+	Restore *CockroachRestoreObservation `json:"restore,omitempty"`
+
+	// VolumeClaims of the nodes.
+	VolumeClaims []v1alpha1.VolumeClaimObservation `json:"volumeClaims,omitempty"`
+}
+
 // A CockroachClusterStatus defines the current state of a CockroachCluster.
 type CockroachClusterStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CockroachClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -141,3 +218,735 @@ type CockroachClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []CockroachCluster `json:"items"`
 }
+
+// A CassandraClusterParameters defines the desired state of a CassandraCluster.
+type CassandraClusterParameters struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// The annotations-related configuration to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// Version of Cassandra to use.
+	Version string `json:"version"`
+	// Repository to pull the image from.
+	Repository *string `json:"repository,omitempty"`
+	// Mode selects an operating mode.
+	// +kubebuilder:validation:Enum=cassandra;scylla
+	Mode string `json:"mode,omitempty"`
+	// Datacenter that will make up this cluster.
+	Datacenter DatacenterSpec `json:"datacenter"`
+	// User-provided image for the sidecar that replaces default.
+	SidecarImage *ImageSpec `json:"sidecarImage,omitempty"`
+}
+
+// DatacenterSpec is the desired state for a Cassandra Datacenter.
+type DatacenterSpec struct {
+	// Name of the Cassandra Datacenter. Used in the cassandra-rackdc.properties file.
+	Name string `json:"name"`
+	// Racks of the specific Datacenter.
+	Racks []RackSpec `json:"racks"`
+}
+
+// RackSpec is the desired state for a Cassandra Rack.
+type RackSpec struct {
+	// Name of the Cassandra Rack. Used in the cassandra-rackdc.properties file.
+	Name string `json:"name"`
+	// Members is the number of Cassandra instances in this rack.
+	Members int32 `json:"members"`
+	// User-provided ConfigMap applied to the specific statefulset.
+	ConfigMapName *string `json:"configMapName,omitempty"`
+	// Storage describes the underlying storage that Cassandra will consume.
+	Storage v1alpha1.StorageScopeSpec `json:"storage"`
+	// The annotations-related configuration to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// Placement describes restrictions for the nodes Cassandra is scheduled on.
+	Placement *v1alpha1.Placement `json:"placement,omitempty"`
+	// Resources the Cassandra Pods will use.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ImageSpec is the desired state for a container image.
+type ImageSpec struct {
+	// Version of the image.
+	Version string `json:"version"`
+	// Repository to pull the image from.
+	Repository string `json:"repository"`
+}
+
+// A CassandraClusterSpec defines the desired state of a CassandraCluster.
+type CassandraClusterSpec struct {
+	xpv1.ResourceSpec          `json:",inline"`
+	CassandraClusterParameters `json:"forProvider"`
+}
+
+// A CassandraClusterStatus defines the current state of a CassandraCluster.
+type CassandraClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CassandraCluster configures a Rook 'clusters.cassandra.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CassandraCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CassandraClusterSpec   `json:"spec"`
+	Status CassandraClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CassandraClusterList contains a list of CassandraCluster
+type CassandraClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CassandraCluster `json:"items"`
+}
+
+// A CockroachClusterReference identifies the CockroachCluster on which a
+// SQL-level resource is managed. The provider connects to the cluster using
+// the connection details the CockroachCluster publishes to its connection
+// secret.
+type CockroachClusterReference struct {
+	// Cluster is the name of the CockroachCluster.
+	Cluster string `json:"cluster,omitempty"`
+	// A reference to the CockroachCluster, used to set its name.
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+	// A selector for a CockroachCluster, used to set its name.
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+}
+
+// A CockroachDatabaseParameters defines the desired state of a
+// CockroachDatabase.
+type CockroachDatabaseParameters struct {
+	// Name of the database.
+	Name string `json:"name"`
+
+	CockroachClusterReference `json:",inline"`
+}
+
+// A CockroachDatabaseSpec defines the desired state of a CockroachDatabase.
+type CockroachDatabaseSpec struct {
+	xpv1.ResourceSpec           `json:",inline"`
+	CockroachDatabaseParameters `json:"forProvider"`
+}
+
+// A CockroachDatabaseStatus defines the current state of a CockroachDatabase.
+type CockroachDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CockroachDatabase is a database in a CockroachCluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CockroachDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CockroachDatabaseSpec   `json:"spec"`
+	Status CockroachDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CockroachDatabaseList contains a list of CockroachDatabase
+type CockroachDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CockroachDatabase `json:"items"`
+}
+
+// A CockroachUserParameters defines the desired state of a CockroachUser.
+type CockroachUserParameters struct {
+	// Name of the user.
+	Name string `json:"name"`
+
+	CockroachClusterReference `json:",inline"`
+}
+
+// A CockroachUserSpec defines the desired state of a CockroachUser.
+type CockroachUserSpec struct {
+	xpv1.ResourceSpec       `json:",inline"`
+	CockroachUserParameters `json:"forProvider"`
+}
+
+// A CockroachUserStatus defines the current state of a CockroachUser.
+type CockroachUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CockroachUser is a user of a CockroachCluster. No password is set for the
+// user, because Rook only initializes insecure clusters, which do not support
+// password authentication.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CockroachUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CockroachUserSpec   `json:"spec"`
+	Status CockroachUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CockroachUserList contains a list of CockroachUser
+type CockroachUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CockroachUser `json:"items"`
+}
+
+// A CockroachPrivilege is a privilege that may be granted on a database.
+// +kubebuilder:validation:Enum=ALL;CREATE;DROP;GRANT;SELECT;INSERT;DELETE;UPDATE
+type CockroachPrivilege string
+
+// A CockroachGrantParameters defines the desired state of a CockroachGrant.
+type CockroachGrantParameters struct {
+	// Privileges granted to the user on the database.
+	// +kubebuilder:validation:MinItems=1
+	Privileges []CockroachPrivilege `json:"privileges"`
+
+	// Database on which privileges are granted.
+	Database string `json:"database,omitempty"`
+	// A reference to the CockroachDatabase on which privileges are granted,
+	// used to set the database.
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+	// A selector for the CockroachDatabase on which privileges are granted,
+	// used to set the database.
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// User to whom privileges are granted.
+	User string `json:"user,omitempty"`
+	// A reference to the CockroachUser to whom privileges are granted, used
+	// to set the user.
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+	// A selector for the CockroachUser to whom privileges are granted, used
+	// to set the user.
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+
+	CockroachClusterReference `json:",inline"`
+}
+
+// A CockroachGrantSpec defines the desired state of a CockroachGrant.
+type CockroachGrantSpec struct {
+	xpv1.ResourceSpec        `json:",inline"`
+	CockroachGrantParameters `json:"forProvider"`
+}
+
+// A CockroachGrantStatus defines the current state of a CockroachGrant.
+type CockroachGrantStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CockroachGrant grants a CockroachUser privileges on a CockroachDatabase.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CockroachGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CockroachGrantSpec   `json:"spec"`
+	Status CockroachGrantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CockroachGrantList contains a list of CockroachGrant
+type CockroachGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CockroachGrant `json:"items"`
+}
+
+// A CockroachClusterSettingParameters defines the desired state of a
+// CockroachClusterSetting.
+type CockroachClusterSettingParameters struct {
+	// Settings maps the names of cluster settings to their values. Values
+	// should be written as SHOW CLUSTER SETTING reports them, for example
+	// 5m0s rather than 5m, or the setting will be reported as drifted.
+	// +kubebuilder:validation:MinProperties=1
+	Settings map[string]string `json:"settings"`
+
+	CockroachClusterReference `json:",inline"`
+}
+
+// A CockroachClusterSettingObservation reflects the observed state of a
+// CockroachClusterSetting.
+type CockroachClusterSettingObservation struct {
+	// Settings maps the names of the declared cluster settings to their
+	// current values.
+	Settings map[string]string `json:"settings,omitempty"`
+}
+
+// A CockroachClusterSettingSpec defines the desired state of a
+// CockroachClusterSetting.
+type CockroachClusterSettingSpec struct {
+	xpv1.ResourceSpec                 `json:",inline"`
+	CockroachClusterSettingParameters `json:"forProvider"`
+}
+
+// A CockroachClusterSettingStatus defines the current state of a
+// CockroachClusterSetting.
+type CockroachClusterSettingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CockroachClusterSettingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CockroachClusterSetting sets cluster settings of a CockroachCluster. The
+// settings are reset to their defaults when it is deleted.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CockroachClusterSetting struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CockroachClusterSettingSpec   `json:"spec"`
+	Status CockroachClusterSettingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CockroachClusterSettingList contains a list of CockroachClusterSetting
+type CockroachClusterSettingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CockroachClusterSetting `json:"items"`
+}
+
+// A CockroachZoneConfigParameters defines the desired state of a
+// CockroachZoneConfig. It configures the zone of either a range, a database,
+// or a table within a database. Replication policies that are omitted are left
+// unchanged.
+type CockroachZoneConfigParameters struct {
+	// Range whose zone is configured.
+	// +kubebuilder:validation:Enum=default
+	// +optional
+	Range string `json:"range,omitempty"`
+
+	// Database whose zone is configured, or that contains the table whose
+	// zone is configured.
+	// +optional
+	Database string `json:"database,omitempty"`
+	// A reference to the CockroachDatabase whose zone is configured, used to
+	// set the database.
+	// +optional
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+	// A selector for the CockroachDatabase whose zone is configured, used to
+	// set the database.
+	// +optional
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// Table whose zone is configured.
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// NumReplicas is the number of replicas of each range in the zone.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NumReplicas *int32 `json:"numReplicas,omitempty"`
+
+	// GCTTLSeconds is the number of seconds for which overwritten values are
+	// retained before they are garbage collected.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	GCTTLSeconds *int32 `json:"gcTTLSeconds,omitempty"`
+
+	// Constraints on the placement of the replicas of each range in the
+	// zone, for example +region=us-east.
+	// +optional
+	Constraints []string `json:"constraints,omitempty"`
+
+	// LeasePreferences for the placement of range leases in the zone, in
+	// order of preference. Each preference is a list of constraints. Lease
+	// preferences were introduced in CockroachDB v2.1, but Rook v1.1 deploys
+	// CockroachDB v2.0, so a zone config that sets them is rejected.
+	// +optional
+	LeasePreferences [][]string `json:"leasePreferences,omitempty"`
+
+	CockroachClusterReference `json:",inline"`
+}
+
+// A CockroachZoneConfigSpec defines the desired state of a
+// CockroachZoneConfig.
+type CockroachZoneConfigSpec struct {
+	xpv1.ResourceSpec             `json:",inline"`
+	CockroachZoneConfigParameters `json:"forProvider"`
+}
+
+// A CockroachZoneConfigStatus defines the current state of a
+// CockroachZoneConfig.
+type CockroachZoneConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CockroachZoneConfig configures the replication and garbage collection
+// policies of a zone of a CockroachCluster. The zone configuration of a
+// database or table is removed when it is deleted. The zone configuration of a
+// range cannot be removed, and is left unchanged.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CockroachZoneConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CockroachZoneConfigSpec   `json:"spec"`
+	Status CockroachZoneConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CockroachZoneConfigList contains a list of CockroachZoneConfig
+type CockroachZoneConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CockroachZoneConfig `json:"items"`
+}
+
+// A YugabyteClusterReference identifies the YugabyteCluster on which a
+// SQL-level resource is managed. The provider connects to the cluster using
+// the connection details the YugabyteCluster publishes to its connection
+// secret.
+type YugabyteClusterReference struct {
+	// Cluster is the name of the YugabyteCluster.
+	Cluster string `json:"cluster,omitempty"`
+	// A reference to the YugabyteCluster, used to set its name.
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+	// A selector for a YugabyteCluster, used to set its name.
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+}
+
+// A YSQLDatabaseParameters defines the desired state of a YSQLDatabase.
+type YSQLDatabaseParameters struct {
+	// Name of the database.
+	Name string `json:"name"`
+
+	// Owner of the database. Defaults to the user the provider connects as.
+	Owner string `json:"owner,omitempty"`
+	// A reference to the YSQLRole that owns the database, used to set the
+	// owner.
+	OwnerRef *xpv1.Reference `json:"ownerRef,omitempty"`
+	// A selector for the YSQLRole that owns the database, used to set the
+	// owner.
+	OwnerSelector *xpv1.Selector `json:"ownerSelector,omitempty"`
+
+	YugabyteClusterReference `json:",inline"`
+}
+
+// A YSQLDatabaseSpec defines the desired state of a YSQLDatabase.
+type YSQLDatabaseSpec struct {
+	xpv1.ResourceSpec      `json:",inline"`
+	YSQLDatabaseParameters `json:"forProvider"`
+}
+
+// A YSQLDatabaseStatus defines the current state of a YSQLDatabase.
+type YSQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A YSQLDatabase is a YSQL database in a YugabyteCluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type YSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YSQLDatabaseSpec   `json:"spec"`
+	Status YSQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// YSQLDatabaseList contains a list of YSQLDatabase
+type YSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YSQLDatabase `json:"items"`
+}
+
+// YSQLRolePrivileges are the privileges of a YSQL role.
+type YSQLRolePrivileges struct {
+	// SuperUser grants the SUPERUSER privilege. Defaults to false.
+	SuperUser *bool `json:"superUser,omitempty"`
+	// CreateDB grants the CREATEDB privilege. Defaults to false.
+	CreateDB *bool `json:"createDb,omitempty"`
+	// CreateRole grants the CREATEROLE privilege. Defaults to false.
+	CreateRole *bool `json:"createRole,omitempty"`
+	// Login grants the LOGIN privilege. Defaults to true.
+	Login *bool `json:"login,omitempty"`
+}
+
+// A YSQLRoleParameters defines the desired state of a YSQLRole.
+type YSQLRoleParameters struct {
+	// Name of the role.
+	Name string `json:"name"`
+
+	// Privileges of the role.
+	Privileges YSQLRolePrivileges `json:"privileges,omitempty"`
+
+	YugabyteClusterReference `json:",inline"`
+}
+
+// A YSQLRoleSpec defines the desired state of a YSQLRole.
+type YSQLRoleSpec struct {
+	xpv1.ResourceSpec  `json:",inline"`
+	YSQLRoleParameters `json:"forProvider"`
+}
+
+// A YSQLRoleStatus defines the current state of a YSQLRole.
+type YSQLRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A YSQLRole is a YSQL role in a YugabyteCluster. No password is set for the
+// role, because Rook starts YugabyteDB without YSQL authentication.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type YSQLRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YSQLRoleSpec   `json:"spec"`
+	Status YSQLRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// YSQLRoleList contains a list of YSQLRole
+type YSQLRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YSQLRole `json:"items"`
+}
+
+// A YCQLReplicationClass is a keyspace replication strategy.
+// +kubebuilder:validation:Enum=SimpleStrategy;NetworkTopologyStrategy
+type YCQLReplicationClass string
+
+// Keyspace replication strategies.
+const (
+	YCQLReplicationSimple          YCQLReplicationClass = "SimpleStrategy"
+	YCQLReplicationNetworkTopology YCQLReplicationClass = "NetworkTopologyStrategy"
+)
+
+// YCQLReplication configures how a YCQL keyspace is replicated.
+type YCQLReplication struct {
+	// Class of the replication strategy.
+	Class YCQLReplicationClass `json:"class"`
+
+	// ReplicationFactor of a keyspace using SimpleStrategy.
+	// +optional
+	ReplicationFactor *int32 `json:"replicationFactor,omitempty"`
+
+	// DataCenters maps each data center to the replication factor of a
+	// keyspace using NetworkTopologyStrategy.
+	// +optional
+	DataCenters map[string]int32 `json:"dataCenters,omitempty"`
+}
+
+// A YCQLKeyspaceParameters defines the desired state of a YCQLKeyspace.
+type YCQLKeyspaceParameters struct {
+	// Name of the keyspace.
+	Name string `json:"name"`
+
+	// Replication with which the keyspace is created. YugabyteDB accepts the
+	// replication options of Cassandra but does not apply them; every
+	// keyspace is replicated according to the replication factor of the
+	// cluster. The replication is therefore not compared with an existing
+	// keyspace, whose actual replication is reported in atProvider.
+	// +optional
+	Replication *YCQLReplication `json:"replication,omitempty"`
+
+	// DurableWrites with which the keyspace is created. Defaults to true.
+	// YugabyteDB accepts but does not apply this option; writes are always
+	// durable.
+	// +optional
+	DurableWrites *bool `json:"durableWrites,omitempty"`
+
+	YugabyteClusterReference `json:",inline"`
+}
+
+// A YCQLKeyspaceSpec defines the desired state of a YCQLKeyspace.
+type YCQLKeyspaceSpec struct {
+	xpv1.ResourceSpec      `json:",inline"`
+	YCQLKeyspaceParameters `json:"forProvider"`
+}
+
+// A YCQLKeyspaceObservation reflects the observed state of a YCQLKeyspace.
+type YCQLKeyspaceObservation struct {
+	// Replication options reported by YugabyteDB.
+	Replication map[string]string `json:"replication,omitempty"`
+
+	// DurableWrites reported by YugabyteDB.
+	DurableWrites bool `json:"durableWrites,omitempty"`
+}
+
+// A YCQLKeyspaceStatus defines the current state of a YCQLKeyspace.
+type YCQLKeyspaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          YCQLKeyspaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A YCQLKeyspace is a YCQL keyspace in a YugabyteCluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type YCQLKeyspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YCQLKeyspaceSpec   `json:"spec"`
+	Status YCQLKeyspaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// YCQLKeyspaceList contains a list of YCQLKeyspace
+type YCQLKeyspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YCQLKeyspace `json:"items"`
+}
+
+// A YCQLPermission is a permission that may be granted on a YCQL keyspace or
+// table.
+// +kubebuilder:validation:Enum=ALL;ALTER;AUTHORIZE;CREATE;DROP;MODIFY;SELECT
+type YCQLPermission string
+
+// YCQL permissions.
+const (
+	YCQLPermissionAll       YCQLPermission = "ALL"
+	YCQLPermissionAlter     YCQLPermission = "ALTER"
+	YCQLPermissionAuthorize YCQLPermission = "AUTHORIZE"
+	YCQLPermissionCreate    YCQLPermission = "CREATE"
+	YCQLPermissionDrop      YCQLPermission = "DROP"
+	YCQLPermissionModify    YCQLPermission = "MODIFY"
+	YCQLPermissionSelect    YCQLPermission = "SELECT"
+)
+
+// A YCQLGrant grants permissions on a YCQL keyspace, or on a table within it.
+type YCQLGrant struct {
+	// Permissions to grant. CREATE may only be granted on a keyspace.
+	// +kubebuilder:validation:MinItems=1
+	Permissions []YCQLPermission `json:"permissions"`
+
+	// Keyspace on which permissions are granted.
+	Keyspace string `json:"keyspace,omitempty"`
+	// A reference to the YCQLKeyspace on which permissions are granted, used
+	// to set the keyspace.
+	KeyspaceRef *xpv1.Reference `json:"keyspaceRef,omitempty"`
+	// A selector for the YCQLKeyspace on which permissions are granted, used
+	// to set the keyspace.
+	KeyspaceSelector *xpv1.Selector `json:"keyspaceSelector,omitempty"`
+
+	// Table within the keyspace on which permissions are granted. Permissions
+	// are granted on the whole keyspace if omitted.
+	// +optional
+	Table string `json:"table,omitempty"`
+}
+
+// YCQLRolePrivileges are the privileges of a YCQL role.
+type YCQLRolePrivileges struct {
+	// SuperUser makes the role a superuser. Defaults to false.
+	SuperUser *bool `json:"superUser,omitempty"`
+	// Login allows the role to log in. Defaults to true.
+	Login *bool `json:"login,omitempty"`
+}
+
+// A YCQLRoleParameters defines the desired state of a YCQLRole.
+type YCQLRoleParameters struct {
+	// Name of the role.
+	Name string `json:"name"`
+
+	// Privileges of the role.
+	Privileges YCQLRolePrivileges `json:"privileges,omitempty"`
+
+	// Grants of permissions on keyspaces and tables to the role. Permissions
+	// that are not listed are revoked.
+	// +optional
+	Grants []YCQLGrant `json:"grants,omitempty"`
+
+	YugabyteClusterReference `json:",inline"`
+}
+
+// A YCQLRoleSpec defines the desired state of a YCQLRole.
+type YCQLRoleSpec struct {
+	xpv1.ResourceSpec  `json:",inline"`
+	YCQLRoleParameters `json:"forProvider"`
+}
+
+// A YCQLRoleStatus defines the current state of a YCQLRole.
+type YCQLRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A YCQLRole is a YCQL role in a YugabyteCluster. No password is set for the
+// role, because Rook starts YugabyteDB without YCQL authentication.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type YCQLRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   YCQLRoleSpec   `json:"spec"`
+	Status YCQLRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// YCQLRoleList contains a list of YCQLRole
+type YCQLRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []YCQLRole `json:"items"`
+}
@@ -21,10 +21,136 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	apisv1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraCluster) DeepCopyInto(out *CassandraCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraCluster.
+func (in *CassandraCluster) DeepCopy() *CassandraCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraClusterList) DeepCopyInto(out *CassandraClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CassandraCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraClusterList.
+func (in *CassandraClusterList) DeepCopy() *CassandraClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CassandraClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraClusterParameters) DeepCopyInto(out *CassandraClusterParameters) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(string)
+		**out = **in
+	}
+	in.Datacenter.DeepCopyInto(&out.Datacenter)
+	if in.SidecarImage != nil {
+		in, out := &in.SidecarImage, &out.SidecarImage
+		*out = new(ImageSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraClusterParameters.
+func (in *CassandraClusterParameters) DeepCopy() *CassandraClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraClusterSpec) DeepCopyInto(out *CassandraClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CassandraClusterParameters.DeepCopyInto(&out.CassandraClusterParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraClusterSpec.
+func (in *CassandraClusterSpec) DeepCopy() *CassandraClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraClusterStatus) DeepCopyInto(out *CassandraClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraClusterStatus.
+func (in *CassandraClusterStatus) DeepCopy() *CassandraClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CockroachCluster) DeepCopyInto(out *CockroachCluster) {
 	*out = *in
@@ -34,18 +160,1182 @@ func (in *CockroachCluster) DeepCopyInto(out *CockroachCluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachCluster.
-func (in *CockroachCluster) DeepCopy() *CockroachCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachCluster.
+func (in *CockroachCluster) DeepCopy() *CockroachCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterList) DeepCopyInto(out *CockroachClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CockroachCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterList.
+func (in *CockroachClusterList) DeepCopy() *CockroachClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterObservation) DeepCopyInto(out *CockroachClusterObservation) {
+	*out = *in
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(CockroachRestoreObservation)
+		**out = **in
+	}
+	if in.VolumeClaims != nil {
+		in, out := &in.VolumeClaims, &out.VolumeClaims
+		*out = make([]apisv1alpha1.VolumeClaimObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterObservation.
+func (in *CockroachClusterObservation) DeepCopy() *CockroachClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterParameters) DeepCopyInto(out *CockroachClusterParameters) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Network.DeepCopyInto(&out.Network)
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(CockroachRestoreSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterParameters.
+func (in *CockroachClusterParameters) DeepCopy() *CockroachClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterReference) DeepCopyInto(out *CockroachClusterReference) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterReference.
+func (in *CockroachClusterReference) DeepCopy() *CockroachClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSetting) DeepCopyInto(out *CockroachClusterSetting) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSetting.
+func (in *CockroachClusterSetting) DeepCopy() *CockroachClusterSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachClusterSetting) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSettingList) DeepCopyInto(out *CockroachClusterSettingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CockroachClusterSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSettingList.
+func (in *CockroachClusterSettingList) DeepCopy() *CockroachClusterSettingList {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSettingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachClusterSettingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSettingObservation) DeepCopyInto(out *CockroachClusterSettingObservation) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSettingObservation.
+func (in *CockroachClusterSettingObservation) DeepCopy() *CockroachClusterSettingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSettingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSettingParameters) DeepCopyInto(out *CockroachClusterSettingParameters) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.CockroachClusterReference.DeepCopyInto(&out.CockroachClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSettingParameters.
+func (in *CockroachClusterSettingParameters) DeepCopy() *CockroachClusterSettingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSettingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSettingSpec) DeepCopyInto(out *CockroachClusterSettingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CockroachClusterSettingParameters.DeepCopyInto(&out.CockroachClusterSettingParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSettingSpec.
+func (in *CockroachClusterSettingSpec) DeepCopy() *CockroachClusterSettingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSettingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSettingStatus) DeepCopyInto(out *CockroachClusterSettingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSettingStatus.
+func (in *CockroachClusterSettingStatus) DeepCopy() *CockroachClusterSettingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSettingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterSpec) DeepCopyInto(out *CockroachClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CockroachClusterParameters.DeepCopyInto(&out.CockroachClusterParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSpec.
+func (in *CockroachClusterSpec) DeepCopy() *CockroachClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachClusterStatus) DeepCopyInto(out *CockroachClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterStatus.
+func (in *CockroachClusterStatus) DeepCopy() *CockroachClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachDatabase) DeepCopyInto(out *CockroachDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachDatabase.
+func (in *CockroachDatabase) DeepCopy() *CockroachDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachDatabaseList) DeepCopyInto(out *CockroachDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CockroachDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachDatabaseList.
+func (in *CockroachDatabaseList) DeepCopy() *CockroachDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachDatabaseParameters) DeepCopyInto(out *CockroachDatabaseParameters) {
+	*out = *in
+	in.CockroachClusterReference.DeepCopyInto(&out.CockroachClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachDatabaseParameters.
+func (in *CockroachDatabaseParameters) DeepCopy() *CockroachDatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachDatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachDatabaseSpec) DeepCopyInto(out *CockroachDatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CockroachDatabaseParameters.DeepCopyInto(&out.CockroachDatabaseParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachDatabaseSpec.
+func (in *CockroachDatabaseSpec) DeepCopy() *CockroachDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachDatabaseStatus) DeepCopyInto(out *CockroachDatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachDatabaseStatus.
+func (in *CockroachDatabaseStatus) DeepCopy() *CockroachDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachGrant) DeepCopyInto(out *CockroachGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachGrant.
+func (in *CockroachGrant) DeepCopy() *CockroachGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachGrantList) DeepCopyInto(out *CockroachGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CockroachGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachGrantList.
+func (in *CockroachGrantList) DeepCopy() *CockroachGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachGrantParameters) DeepCopyInto(out *CockroachGrantParameters) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]CockroachPrivilege, len(*in))
+		copy(*out, *in)
+	}
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CockroachClusterReference.DeepCopyInto(&out.CockroachClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachGrantParameters.
+func (in *CockroachGrantParameters) DeepCopy() *CockroachGrantParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachGrantParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachGrantSpec) DeepCopyInto(out *CockroachGrantSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CockroachGrantParameters.DeepCopyInto(&out.CockroachGrantParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachGrantSpec.
+func (in *CockroachGrantSpec) DeepCopy() *CockroachGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachGrantStatus) DeepCopyInto(out *CockroachGrantStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachGrantStatus.
+func (in *CockroachGrantStatus) DeepCopy() *CockroachGrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachGrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachRestoreObservation) DeepCopyInto(out *CockroachRestoreObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachRestoreObservation.
+func (in *CockroachRestoreObservation) DeepCopy() *CockroachRestoreObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachRestoreObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachRestoreSource) DeepCopyInto(out *CockroachRestoreSource) {
+	*out = *in
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachRestoreSource.
+func (in *CockroachRestoreSource) DeepCopy() *CockroachRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachUser) DeepCopyInto(out *CockroachUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachUser.
+func (in *CockroachUser) DeepCopy() *CockroachUser {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachUserList) DeepCopyInto(out *CockroachUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CockroachUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachUserList.
+func (in *CockroachUserList) DeepCopy() *CockroachUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachUserParameters) DeepCopyInto(out *CockroachUserParameters) {
+	*out = *in
+	in.CockroachClusterReference.DeepCopyInto(&out.CockroachClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachUserParameters.
+func (in *CockroachUserParameters) DeepCopy() *CockroachUserParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachUserParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachUserSpec) DeepCopyInto(out *CockroachUserSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CockroachUserParameters.DeepCopyInto(&out.CockroachUserParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachUserSpec.
+func (in *CockroachUserSpec) DeepCopy() *CockroachUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachUserStatus) DeepCopyInto(out *CockroachUserStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachUserStatus.
+func (in *CockroachUserStatus) DeepCopy() *CockroachUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachZoneConfig) DeepCopyInto(out *CockroachZoneConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachZoneConfig.
+func (in *CockroachZoneConfig) DeepCopy() *CockroachZoneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachZoneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachZoneConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachZoneConfigList) DeepCopyInto(out *CockroachZoneConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CockroachZoneConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachZoneConfigList.
+func (in *CockroachZoneConfigList) DeepCopy() *CockroachZoneConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachZoneConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CockroachZoneConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachZoneConfigParameters) DeepCopyInto(out *CockroachZoneConfigParameters) {
+	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NumReplicas != nil {
+		in, out := &in.NumReplicas, &out.NumReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GCTTLSeconds != nil {
+		in, out := &in.GCTTLSeconds, &out.GCTTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LeasePreferences != nil {
+		in, out := &in.LeasePreferences, &out.LeasePreferences
+		*out = make([][]string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+		}
+	}
+	in.CockroachClusterReference.DeepCopyInto(&out.CockroachClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachZoneConfigParameters.
+func (in *CockroachZoneConfigParameters) DeepCopy() *CockroachZoneConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachZoneConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachZoneConfigSpec) DeepCopyInto(out *CockroachZoneConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CockroachZoneConfigParameters.DeepCopyInto(&out.CockroachZoneConfigParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachZoneConfigSpec.
+func (in *CockroachZoneConfigSpec) DeepCopy() *CockroachZoneConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachZoneConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CockroachZoneConfigStatus) DeepCopyInto(out *CockroachZoneConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachZoneConfigStatus.
+func (in *CockroachZoneConfigStatus) DeepCopy() *CockroachZoneConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CockroachZoneConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterSpec) DeepCopyInto(out *DatacenterSpec) {
+	*out = *in
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]RackSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterSpec.
+func (in *DatacenterSpec) DeepCopy() *DatacenterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
+func (in *PortSpec) DeepCopy() *PortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackSpec) DeepCopyInto(out *RackSpec) {
+	*out = *in
+	if in.ConfigMapName != nil {
+		in, out := &in.ConfigMapName, &out.ConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apisv1alpha1.Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackSpec.
+func (in *RackSpec) DeepCopy() *RackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSpec) DeepCopyInto(out *ServerSpec) {
+	*out = *in
+	in.Network.DeepCopyInto(&out.Network)
+	in.VolumeClaimTemplate.DeepCopyInto(&out.VolumeClaimTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSpec.
+func (in *ServerSpec) DeepCopy() *ServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLGrant) DeepCopyInto(out *YCQLGrant) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]YCQLPermission, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyspaceRef != nil {
+		in, out := &in.KeyspaceRef, &out.KeyspaceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyspaceSelector != nil {
+		in, out := &in.KeyspaceSelector, &out.KeyspaceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLGrant.
+func (in *YCQLGrant) DeepCopy() *YCQLGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLKeyspace) DeepCopyInto(out *YCQLKeyspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLKeyspace.
+func (in *YCQLKeyspace) DeepCopy() *YCQLKeyspace {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLKeyspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YCQLKeyspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLKeyspaceList) DeepCopyInto(out *YCQLKeyspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]YCQLKeyspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLKeyspaceList.
+func (in *YCQLKeyspaceList) DeepCopy() *YCQLKeyspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLKeyspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YCQLKeyspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLKeyspaceObservation) DeepCopyInto(out *YCQLKeyspaceObservation) {
+	*out = *in
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLKeyspaceObservation.
+func (in *YCQLKeyspaceObservation) DeepCopy() *YCQLKeyspaceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLKeyspaceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLKeyspaceParameters) DeepCopyInto(out *YCQLKeyspaceParameters) {
+	*out = *in
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(YCQLReplication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DurableWrites != nil {
+		in, out := &in.DurableWrites, &out.DurableWrites
+		*out = new(bool)
+		**out = **in
+	}
+	in.YugabyteClusterReference.DeepCopyInto(&out.YugabyteClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLKeyspaceParameters.
+func (in *YCQLKeyspaceParameters) DeepCopy() *YCQLKeyspaceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLKeyspaceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLKeyspaceSpec) DeepCopyInto(out *YCQLKeyspaceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.YCQLKeyspaceParameters.DeepCopyInto(&out.YCQLKeyspaceParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLKeyspaceSpec.
+func (in *YCQLKeyspaceSpec) DeepCopy() *YCQLKeyspaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLKeyspaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLKeyspaceStatus) DeepCopyInto(out *YCQLKeyspaceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLKeyspaceStatus.
+func (in *YCQLKeyspaceStatus) DeepCopy() *YCQLKeyspaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLKeyspaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLReplication) DeepCopyInto(out *YCQLReplication) {
+	*out = *in
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DataCenters != nil {
+		in, out := &in.DataCenters, &out.DataCenters
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLReplication.
+func (in *YCQLReplication) DeepCopy() *YCQLReplication {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLReplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLRole) DeepCopyInto(out *YCQLRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLRole.
+func (in *YCQLRole) DeepCopy() *YCQLRole {
 	if in == nil {
 		return nil
 	}
-	out := new(CockroachCluster)
+	out := new(YCQLRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CockroachCluster) DeepCopyObject() runtime.Object {
+func (in *YCQLRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,31 +1343,31 @@ func (in *CockroachCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CockroachClusterList) DeepCopyInto(out *CockroachClusterList) {
+func (in *YCQLRoleList) DeepCopyInto(out *YCQLRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CockroachCluster, len(*in))
+		*out = make([]YCQLRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterList.
-func (in *CockroachClusterList) DeepCopy() *CockroachClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLRoleList.
+func (in *YCQLRoleList) DeepCopy() *YCQLRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(CockroachClusterList)
+	out := new(YCQLRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CockroachClusterList) DeepCopyObject() runtime.Object {
+func (in *YCQLRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -85,110 +1375,345 @@ func (in *CockroachClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CockroachClusterParameters) DeepCopyInto(out *CockroachClusterParameters) {
+func (in *YCQLRoleParameters) DeepCopyInto(out *YCQLRoleParameters) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(apisv1alpha1.Annotations, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	in.Privileges.DeepCopyInto(&out.Privileges)
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]YCQLGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.Storage.DeepCopyInto(&out.Storage)
-	in.Network.DeepCopyInto(&out.Network)
+	in.YugabyteClusterReference.DeepCopyInto(&out.YugabyteClusterReference)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterParameters.
-func (in *CockroachClusterParameters) DeepCopy() *CockroachClusterParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLRoleParameters.
+func (in *YCQLRoleParameters) DeepCopy() *YCQLRoleParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(CockroachClusterParameters)
+	out := new(YCQLRoleParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CockroachClusterSpec) DeepCopyInto(out *CockroachClusterSpec) {
+func (in *YCQLRolePrivileges) DeepCopyInto(out *YCQLRolePrivileges) {
+	*out = *in
+	if in.SuperUser != nil {
+		in, out := &in.SuperUser, &out.SuperUser
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Login != nil {
+		in, out := &in.Login, &out.Login
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLRolePrivileges.
+func (in *YCQLRolePrivileges) DeepCopy() *YCQLRolePrivileges {
+	if in == nil {
+		return nil
+	}
+	out := new(YCQLRolePrivileges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YCQLRoleSpec) DeepCopyInto(out *YCQLRoleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.CockroachClusterParameters.DeepCopyInto(&out.CockroachClusterParameters)
+	in.YCQLRoleParameters.DeepCopyInto(&out.YCQLRoleParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterSpec.
-func (in *CockroachClusterSpec) DeepCopy() *CockroachClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLRoleSpec.
+func (in *YCQLRoleSpec) DeepCopy() *YCQLRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CockroachClusterSpec)
+	out := new(YCQLRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CockroachClusterStatus) DeepCopyInto(out *CockroachClusterStatus) {
+func (in *YCQLRoleStatus) DeepCopyInto(out *YCQLRoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CockroachClusterStatus.
-func (in *CockroachClusterStatus) DeepCopy() *CockroachClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YCQLRoleStatus.
+func (in *YCQLRoleStatus) DeepCopy() *YCQLRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CockroachClusterStatus)
+	out := new(YCQLRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+func (in *YSQLDatabase) DeepCopyInto(out *YSQLDatabase) {
 	*out = *in
-	if in.Ports != nil {
-		in, out := &in.Ports, &out.Ports
-		*out = make([]PortSpec, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLDatabase.
+func (in *YSQLDatabase) DeepCopy() *YSQLDatabase {
+	if in == nil {
+		return nil
 	}
+	out := new(YSQLDatabase)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
-func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YSQLDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLDatabaseList) DeepCopyInto(out *YSQLDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]YSQLDatabase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLDatabaseList.
+func (in *YSQLDatabaseList) DeepCopy() *YSQLDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkSpec)
+	out := new(YSQLDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YSQLDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+func (in *YSQLDatabaseParameters) DeepCopyInto(out *YSQLDatabaseParameters) {
 	*out = *in
+	if in.OwnerRef != nil {
+		in, out := &in.OwnerRef, &out.OwnerRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OwnerSelector != nil {
+		in, out := &in.OwnerSelector, &out.OwnerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.YugabyteClusterReference.DeepCopyInto(&out.YugabyteClusterReference)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
-func (in *PortSpec) DeepCopy() *PortSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLDatabaseParameters.
+func (in *YSQLDatabaseParameters) DeepCopy() *YSQLDatabaseParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PortSpec)
+	out := new(YSQLDatabaseParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerSpec) DeepCopyInto(out *ServerSpec) {
+func (in *YSQLDatabaseSpec) DeepCopyInto(out *YSQLDatabaseSpec) {
 	*out = *in
-	in.Network.DeepCopyInto(&out.Network)
-	in.VolumeClaimTemplate.DeepCopyInto(&out.VolumeClaimTemplate)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.YSQLDatabaseParameters.DeepCopyInto(&out.YSQLDatabaseParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSpec.
-func (in *ServerSpec) DeepCopy() *ServerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLDatabaseSpec.
+func (in *YSQLDatabaseSpec) DeepCopy() *YSQLDatabaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServerSpec)
+	out := new(YSQLDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLDatabaseStatus) DeepCopyInto(out *YSQLDatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLDatabaseStatus.
+func (in *YSQLDatabaseStatus) DeepCopy() *YSQLDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLRole) DeepCopyInto(out *YSQLRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLRole.
+func (in *YSQLRole) DeepCopy() *YSQLRole {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YSQLRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLRoleList) DeepCopyInto(out *YSQLRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]YSQLRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLRoleList.
+func (in *YSQLRoleList) DeepCopy() *YSQLRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *YSQLRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLRoleParameters) DeepCopyInto(out *YSQLRoleParameters) {
+	*out = *in
+	in.Privileges.DeepCopyInto(&out.Privileges)
+	in.YugabyteClusterReference.DeepCopyInto(&out.YugabyteClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLRoleParameters.
+func (in *YSQLRoleParameters) DeepCopy() *YSQLRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLRolePrivileges) DeepCopyInto(out *YSQLRolePrivileges) {
+	*out = *in
+	if in.SuperUser != nil {
+		in, out := &in.SuperUser, &out.SuperUser
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreateDB != nil {
+		in, out := &in.CreateDB, &out.CreateDB
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreateRole != nil {
+		in, out := &in.CreateRole, &out.CreateRole
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Login != nil {
+		in, out := &in.Login, &out.Login
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLRolePrivileges.
+func (in *YSQLRolePrivileges) DeepCopy() *YSQLRolePrivileges {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLRolePrivileges)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLRoleSpec) DeepCopyInto(out *YSQLRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.YSQLRoleParameters.DeepCopyInto(&out.YSQLRoleParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLRoleSpec.
+func (in *YSQLRoleSpec) DeepCopy() *YSQLRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YSQLRoleStatus) DeepCopyInto(out *YSQLRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YSQLRoleStatus.
+func (in *YSQLRoleStatus) DeepCopy() *YSQLRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(YSQLRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -252,6 +1777,31 @@ func (in *YugabyteClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YugabyteClusterObservation) DeepCopyInto(out *YugabyteClusterObservation) {
+	*out = *in
+	if in.BlacklistedTServers != nil {
+		in, out := &in.BlacklistedTServers, &out.BlacklistedTServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeClaims != nil {
+		in, out := &in.VolumeClaims, &out.VolumeClaims
+		*out = make([]apisv1alpha1.VolumeClaimObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YugabyteClusterObservation.
+func (in *YugabyteClusterObservation) DeepCopy() *YugabyteClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(YugabyteClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *YugabyteClusterParameters) DeepCopyInto(out *YugabyteClusterParameters) {
 	*out = *in
@@ -276,6 +1826,31 @@ func (in *YugabyteClusterParameters) DeepCopy() *YugabyteClusterParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YugabyteClusterReference) DeepCopyInto(out *YugabyteClusterReference) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YugabyteClusterReference.
+func (in *YugabyteClusterReference) DeepCopy() *YugabyteClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(YugabyteClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *YugabyteClusterSpec) DeepCopyInto(out *YugabyteClusterSpec) {
 	*out = *in
@@ -297,6 +1872,7 @@ func (in *YugabyteClusterSpec) DeepCopy() *YugabyteClusterSpec {
 func (in *YugabyteClusterStatus) DeepCopyInto(out *YugabyteClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YugabyteClusterStatus.
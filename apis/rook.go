@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	databasev1alpha1 "github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	storagev1alpha1 "github.com/crossplane/provider-rook/apis/storage/v1alpha1"
 	rookv1beta1 "github.com/crossplane/provider-rook/apis/v1beta1"
 )
 
@@ -28,6 +29,7 @@ func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
 		databasev1alpha1.SchemeBuilder.AddToScheme,
+		storagev1alpha1.SchemeBuilder.AddToScheme,
 		rookv1beta1.SchemeBuilder.AddToScheme,
 	)
 }
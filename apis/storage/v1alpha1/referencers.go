@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errResolveClusterNamespace = "cannot resolve namespace of referenced EdgefsCluster"
+
+// EdgefsClusterNamespace extracts the namespace of a resolved EdgefsCluster.
+// EdgeFS services are served by the EdgeFS cluster running in the same
+// namespace.
+func EdgefsClusterNamespace() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*EdgefsCluster)
+		if !ok {
+			return ""
+		}
+		return c.Spec.EdgefsClusterParameters.Namespace
+	}
+}
+
+func resolveClusterNamespace(ctx context.Context, r *reference.APIResolver, ns *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *ns,
+		Reference:    *ref,
+		Selector:     sel,
+		To:           reference.To{Managed: &EdgefsCluster{}, List: &EdgefsClusterList{}},
+		Extract:      EdgefsClusterNamespace(),
+	})
+	if err != nil {
+		return errors.Wrap(err, errResolveClusterNamespace)
+	}
+	*ns = rsp.ResolvedValue
+	*ref = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this EdgefsNFS.
+func (mg *EdgefsNFS) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.EdgefsNFSParameters
+	return resolveClusterNamespace(ctx, reference.NewAPIResolver(c, mg), &p.Namespace, &p.ClusterRef, p.ClusterSelector)
+}
+
+// ResolveReferences of this EdgefsS3.
+func (mg *EdgefsS3) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.EdgefsS3Parameters
+	return resolveClusterNamespace(ctx, reference.NewAPIResolver(c, mg), &p.Namespace, &p.ClusterRef, p.ClusterSelector)
+}
+
+// ResolveReferences of this EdgefsSWIFT.
+func (mg *EdgefsSWIFT) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.EdgefsSWIFTParameters
+	return resolveClusterNamespace(ctx, reference.NewAPIResolver(c, mg), &p.Namespace, &p.ClusterRef, p.ClusterSelector)
+}
+
+// ResolveReferences of this EdgefsISCSI.
+func (mg *EdgefsISCSI) ResolveReferences(ctx context.Context, c client.Reader) error {
+	p := &mg.Spec.EdgefsISCSIParameters
+	return resolveClusterNamespace(ctx, reference.NewAPIResolver(c, mg), &p.Namespace, &p.ClusterRef, p.ClusterSelector)
+}
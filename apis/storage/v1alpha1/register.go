@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "storage.rook.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// NFSServer type metadata.
+var (
+	NFSServerKind             = reflect.TypeOf(NFSServer{}).Name()
+	NFSServerKindAPIVersion   = NFSServerKind + "." + SchemeGroupVersion.String()
+	NFSServerGroupVersionKind = SchemeGroupVersion.WithKind(NFSServerKind)
+)
+
+// MinioObjectStore type metadata.
+var (
+	MinioObjectStoreKind             = reflect.TypeOf(MinioObjectStore{}).Name()
+	MinioObjectStoreKindAPIVersion   = MinioObjectStoreKind + "." + SchemeGroupVersion.String()
+	MinioObjectStoreGroupVersionKind = SchemeGroupVersion.WithKind(MinioObjectStoreKind)
+)
+
+// EdgefsCluster type metadata.
+var (
+	EdgefsClusterKind             = reflect.TypeOf(EdgefsCluster{}).Name()
+	EdgefsClusterKindAPIVersion   = EdgefsClusterKind + "." + SchemeGroupVersion.String()
+	EdgefsClusterGroupVersionKind = SchemeGroupVersion.WithKind(EdgefsClusterKind)
+)
+
+// EdgefsNFS type metadata.
+var (
+	EdgefsNFSKind             = reflect.TypeOf(EdgefsNFS{}).Name()
+	EdgefsNFSKindAPIVersion   = EdgefsNFSKind + "." + SchemeGroupVersion.String()
+	EdgefsNFSGroupVersionKind = SchemeGroupVersion.WithKind(EdgefsNFSKind)
+)
+
+// EdgefsS3 type metadata.
+var (
+	EdgefsS3Kind             = reflect.TypeOf(EdgefsS3{}).Name()
+	EdgefsS3KindAPIVersion   = EdgefsS3Kind + "." + SchemeGroupVersion.String()
+	EdgefsS3GroupVersionKind = SchemeGroupVersion.WithKind(EdgefsS3Kind)
+)
+
+// EdgefsSWIFT type metadata.
+var (
+	EdgefsSWIFTKind             = reflect.TypeOf(EdgefsSWIFT{}).Name()
+	EdgefsSWIFTKindAPIVersion   = EdgefsSWIFTKind + "." + SchemeGroupVersion.String()
+	EdgefsSWIFTGroupVersionKind = SchemeGroupVersion.WithKind(EdgefsSWIFTKind)
+)
+
+// EdgefsISCSI type metadata.
+var (
+	EdgefsISCSIKind             = reflect.TypeOf(EdgefsISCSI{}).Name()
+	EdgefsISCSIKindAPIVersion   = EdgefsISCSIKind + "." + SchemeGroupVersion.String()
+	EdgefsISCSIGroupVersionKind = SchemeGroupVersion.WithKind(EdgefsISCSIKind)
+)
+
+// CephStorageClass type metadata.
+var (
+	CephStorageClassKind             = reflect.TypeOf(CephStorageClass{}).Name()
+	CephStorageClassKindAPIVersion   = CephStorageClassKind + "." + SchemeGroupVersion.String()
+	CephStorageClassGroupVersionKind = SchemeGroupVersion.WithKind(CephStorageClassKind)
+)
+
+// CephVolumeSnapshotClass type metadata.
+var (
+	CephVolumeSnapshotClassKind             = reflect.TypeOf(CephVolumeSnapshotClass{}).Name()
+	CephVolumeSnapshotClassKindAPIVersion   = CephVolumeSnapshotClassKind + "." + SchemeGroupVersion.String()
+	CephVolumeSnapshotClassGroupVersionKind = SchemeGroupVersion.WithKind(CephVolumeSnapshotClassKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NFSServer{}, &NFSServerList{})
+	SchemeBuilder.Register(&MinioObjectStore{}, &MinioObjectStoreList{})
+	SchemeBuilder.Register(&EdgefsCluster{}, &EdgefsClusterList{})
+	SchemeBuilder.Register(&EdgefsNFS{}, &EdgefsNFSList{})
+	SchemeBuilder.Register(&EdgefsS3{}, &EdgefsS3List{})
+	SchemeBuilder.Register(&EdgefsSWIFT{}, &EdgefsSWIFTList{})
+	SchemeBuilder.Register(&EdgefsISCSI{}, &EdgefsISCSIList{})
+	SchemeBuilder.Register(&CephStorageClass{}, &CephStorageClassList{})
+	SchemeBuilder.Register(&CephVolumeSnapshotClass{}, &CephVolumeSnapshotClassList{})
+}
@@ -0,0 +1,712 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+// ExportsSpec represents the spec of NFS exports
+type ExportsSpec struct {
+	// Name of the export
+	Name string `json:"name,omitempty"`
+
+	// The NFS server configuration
+	Server ServerSpec `json:"server,omitempty"`
+
+	// PVC from which the NFS daemon gets storage for sharing
+	PersistentVolumeClaim corev1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+}
+
+// ServerSpec represents the spec for configuring the NFS server
+type ServerSpec struct {
+	// Reading and Writing permissions on the export
+	// +kubebuilder:validation:Enum=ReadOnly;ReadWrite;none
+	AccessMode string `json:"accessMode,omitempty"`
+
+	// This prevents the root users connected remotely from having root privileges
+	// +kubebuilder:validation:Enum=none;rootid;root;all
+	Squash string `json:"squash,omitempty"`
+
+	// The clients allowed to access the NFS export
+	AllowedClients []AllowedClientsSpec `json:"allowedClients,omitempty"`
+}
+
+// AllowedClientsSpec represents the client specs for accessing the NFS export
+type AllowedClientsSpec struct {
+	// Name of the clients group
+	Name string `json:"name,omitempty"`
+
+	// The clients that can access the share
+	// Values can be hostname, ip address, netgroup, CIDR network address, or all
+	Clients []string `json:"clients,omitempty"`
+
+	// Reading and Writing permissions for the client to access the NFS export
+	// Gets overridden when ServerSpec.accessMode is specified
+	// +kubebuilder:validation:Enum=ReadOnly;ReadWrite;none
+	AccessMode string `json:"accessMode,omitempty"`
+
+	// Squash options for clients
+	// Gets overridden when ServerSpec.squash is specified
+	// +kubebuilder:validation:Enum=none;rootid;root;all
+	Squash string `json:"squash,omitempty"`
+}
+
+// A NFSServerParameters defines the desired state of a NFSServer.
+type NFSServerParameters struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// The annotations-related configuration to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// Replicas of the NFS daemon
+	Replicas int `json:"replicas,omitempty"`
+	// The parameters to configure the NFS export
+	Exports []ExportsSpec `json:"exports,omitempty"`
+}
+
+// A NFSServerSpec defines the desired state of a NFSServer.
+type NFSServerSpec struct {
+	xpv1.ResourceSpec   `json:",inline"`
+	NFSServerParameters `json:"forProvider"`
+}
+
+// A NFSServerStatus defines the current state of a NFSServer.
+type NFSServerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NFSServer configures a Rook 'nfsservers.nfs.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type NFSServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NFSServerSpec   `json:"spec"`
+	Status NFSServerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NFSServerList contains a list of NFSServer
+type NFSServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NFSServer `json:"items"`
+}
+
+// A MinioObjectStoreParameters defines the desired state of a
+// MinioObjectStore.
+type MinioObjectStoreParameters struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// The annotations to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// A spec for available storage in the cluster and how it should be used.
+	// Rook requires an even node count of at least four.
+	Storage v1alpha1.StorageScopeSpec `json:"scope,omitempty"`
+	// A reference to a Secret in the target cluster that contains the access
+	// key and secret key of the object store under the 'username' and
+	// 'password' keys respectively.
+	Credentials corev1.SecretReference `json:"credentials"`
+	// The amount of storage that will be available in the object store.
+	StorageSize string `json:"storageAmount"`
+	// ClusterDomain is the local cluster domain for this cluster. This should
+	// be set if an alternative cluster domain is in use. If not set, then the
+	// default of cluster.local will be assumed.
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+}
+
+// A MinioObjectStoreSpec defines the desired state of a MinioObjectStore.
+type MinioObjectStoreSpec struct {
+	xpv1.ResourceSpec          `json:",inline"`
+	MinioObjectStoreParameters `json:"forProvider"`
+}
+
+// A MinioObjectStoreStatus defines the current state of a MinioObjectStore.
+type MinioObjectStoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MinioObjectStore configures a Rook 'objectstores.minio.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type MinioObjectStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MinioObjectStoreSpec   `json:"spec"`
+	Status MinioObjectStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MinioObjectStoreList contains a list of MinioObjectStore
+type MinioObjectStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MinioObjectStore `json:"items"`
+}
+
+// EdgefsStorageSpec represents the storage that will be used by an EdgeFS
+// cluster and how it should be used.
+type EdgefsStorageSpec struct {
+	// Whether to consume storage on all nodes of the cluster.
+	UseAllNodes bool `json:"useAllNodes,omitempty"`
+	// Whether to consume all the storage devices found on a machine.
+	UseAllDevices *bool `json:"useAllDevices,omitempty"`
+	// A regular expression to allow more fine-grained selection of devices
+	// on nodes across the cluster.
+	DeviceFilter string `json:"deviceFilter,omitempty"`
+	// List of host directories to use as storage.
+	Directories []string `json:"directories,omitempty"`
+	// EdgeFS specific storage configuration, for example 'useMetadataOffload'
+	// or 'rtVerifyChid'.
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// NetworkSpec represents the network configuration of an EdgeFS cluster.
+type NetworkSpec struct {
+	// Provider is what provides network connectivity to the cluster, for
+	// example 'host' or 'multus'.
+	Provider string `json:"provider"`
+	// Selectors describe what networks will be used to connect the cluster.
+	Selectors map[string]string `json:"selectors,omitempty"`
+}
+
+// An EdgefsClusterParameters defines the desired state of an EdgefsCluster.
+type EdgefsClusterParameters struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// The annotations to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// A spec for available storage in the cluster and how it should be used.
+	Storage EdgefsStorageSpec `json:"storage,omitempty"`
+	// The placement-related configuration to pass to kubernetes (affinity,
+	// tolerations) for all EdgeFS Pods.
+	Placement *v1alpha1.Placement `json:"placement,omitempty"`
+	// The network configuration of the cluster.
+	Network *NetworkSpec `json:"network,omitempty"`
+	// Resources set resource requests and limits for the EdgeFS targets.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// The path on the host where config and data can be persisted.
+	DataDirHostPath string `json:"dataDirHostPath,omitempty"`
+	// The service account under which the EdgeFS Pods run.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// How to treat devices that were previously provisioned by EdgeFS.
+	// +kubebuilder:validation:Enum=restore;restoreZap;restoreZapWait
+	DevicesResurrectMode string `json:"devicesResurrectMode,omitempty"`
+	// The EdgeFS image to run.
+	EdgefsImageName string `json:"edgefsImageName,omitempty"`
+	// Whether to skip preparing the hosts of the cluster.
+	SkipHostPrepare bool `json:"skipHostPrepare,omitempty"`
+	// The resource profile of the cluster.
+	// +kubebuilder:validation:Enum=embedded;performance
+	ResourceProfile string `json:"resourceProfile,omitempty"`
+	// Whether to use the local time of the host rather than UTC.
+	UseHostLocalTime bool `json:"useHostLocalTime,omitempty"`
+}
+
+// An EdgefsClusterSpec defines the desired state of an EdgefsCluster.
+type EdgefsClusterSpec struct {
+	xpv1.ResourceSpec       `json:",inline"`
+	EdgefsClusterParameters `json:"forProvider"`
+}
+
+// An EdgefsClusterStatus defines the current state of an EdgefsCluster.
+type EdgefsClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EdgefsCluster configures a Rook 'clusters.edgefs.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type EdgefsCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EdgefsClusterSpec   `json:"spec"`
+	Status EdgefsClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EdgefsClusterList contains a list of EdgefsCluster
+type EdgefsClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EdgefsCluster `json:"items"`
+}
+
+// An EdgefsNFSParameters defines the desired state of an EdgefsNFS.
+type EdgefsNFSParameters struct {
+	Name string `json:"name"`
+	// The namespace of the EdgeFS cluster that serves this NFS service.
+	Namespace string `json:"namespace,omitempty"`
+	// A reference to the EdgefsCluster that serves this NFS service, used to
+	// set its namespace.
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+	// A selector for an EdgefsCluster that serves this NFS service, used to
+	// set its namespace.
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+	// The annotations to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// The placement-related configuration to pass to kubernetes (affinity,
+	// tolerations).
+	Placement *v1alpha1.Placement `json:"placement,omitempty"`
+	// Resources set resource requests and limits.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// The number of pods in the NFS service.
+	Instances int32 `json:"instances"`
+	// Whether to relax directory updates, trading consistency for speed.
+	RelaxedDirUpdates bool `json:"relaxedDirUpdates,omitempty"`
+	// The resource profile of the service.
+	// +kubebuilder:validation:Enum=embedded;performance
+	ResourceProfile string `json:"resourceProfile,omitempty"`
+}
+
+// An EdgefsNFSSpec defines the desired state of an EdgefsNFS.
+type EdgefsNFSSpec struct {
+	xpv1.ResourceSpec   `json:",inline"`
+	EdgefsNFSParameters `json:"forProvider"`
+}
+
+// An EdgefsNFSStatus defines the current state of an EdgefsNFS.
+type EdgefsNFSStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EdgefsNFS configures a Rook 'nfss.edgefs.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type EdgefsNFS struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EdgefsNFSSpec   `json:"spec"`
+	Status EdgefsNFSStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EdgefsNFSList contains a list of EdgefsNFS
+type EdgefsNFSList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EdgefsNFS `json:"items"`
+}
+
+// An EdgefsS3Parameters defines the desired state of an EdgefsS3.
+type EdgefsS3Parameters struct {
+	Name string `json:"name"`
+	// The namespace of the EdgeFS cluster that serves this S3 service.
+	Namespace string `json:"namespace,omitempty"`
+	// A reference to the EdgefsCluster that serves this S3 service, used to
+	// set its namespace.
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+	// A selector for an EdgefsCluster that serves this S3 service, used to
+	// set its namespace.
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+	// The annotations to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// The placement-related configuration to pass to kubernetes (affinity,
+	// tolerations).
+	Placement *v1alpha1.Placement `json:"placement,omitempty"`
+	// Resources set resource requests and limits.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// The number of pods in the S3 service.
+	Instances int32 `json:"instances"`
+	// The port on which the S3 service listens. Defaults to 9982.
+	Port uint `json:"port,omitempty"`
+	// The secure port on which the S3 service listens. Defaults to 9443.
+	SecurePort uint `json:"securePort,omitempty"`
+	// The type of the Kubernetes service exposing the S3 service.
+	ServiceType string `json:"serviceType,omitempty"`
+	// The external port of the S3 service when exposed as a node port.
+	ExternalPort uint `json:"externalPort,omitempty"`
+	// The external secure port of the S3 service when exposed as a node port.
+	SecureExternalPort uint `json:"secureExternalPort,omitempty"`
+	// The name of a Secret that contains the SSL certificate of the service.
+	SSLCertificateRef string `json:"sslCertificateRef,omitempty"`
+	// The flavour of S3 API to serve.
+	// +kubebuilder:validation:Enum=s3;s3s
+	S3Type string `json:"s3type,omitempty"`
+	// The resource profile of the service.
+	// +kubebuilder:validation:Enum=embedded;performance
+	ResourceProfile string `json:"resourceProfile,omitempty"`
+}
+
+// An EdgefsS3Spec defines the desired state of an EdgefsS3.
+type EdgefsS3Spec struct {
+	xpv1.ResourceSpec  `json:",inline"`
+	EdgefsS3Parameters `json:"forProvider"`
+}
+
+// An EdgefsS3Status defines the current state of an EdgefsS3.
+type EdgefsS3Status struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EdgefsS3 configures a Rook 's3s.edgefs.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type EdgefsS3 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EdgefsS3Spec   `json:"spec"`
+	Status EdgefsS3Status `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EdgefsS3List contains a list of EdgefsS3
+type EdgefsS3List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EdgefsS3 `json:"items"`
+}
+
+// An EdgefsSWIFTParameters defines the desired state of an EdgefsSWIFT.
+type EdgefsSWIFTParameters struct {
+	Name string `json:"name"`
+	// The namespace of the EdgeFS cluster that serves this SWIFT service.
+	Namespace string `json:"namespace,omitempty"`
+	// A reference to the EdgefsCluster that serves this SWIFT service, used
+	// to set its namespace.
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+	// A selector for an EdgefsCluster that serves this SWIFT service, used to
+	// set its namespace.
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+	// The placement-related configuration to pass to kubernetes (affinity,
+	// tolerations).
+	Placement *v1alpha1.Placement `json:"placement,omitempty"`
+	// Resources set resource requests and limits.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// The number of pods in the SWIFT service.
+	Instances int32 `json:"instances"`
+	// The port on which the SWIFT service listens. Defaults to 9981.
+	Port uint `json:"port,omitempty"`
+	// The secure port on which the SWIFT service listens. Defaults to 443.
+	SecurePort uint `json:"securePort,omitempty"`
+	// The type of the Kubernetes service exposing the SWIFT service.
+	ServiceType string `json:"serviceType,omitempty"`
+	// The external port of the SWIFT service when exposed as a node port.
+	ExternalPort uint `json:"externalPort,omitempty"`
+	// The external secure port of the SWIFT service when exposed as a node
+	// port.
+	SecureExternalPort uint `json:"secureExternalPort,omitempty"`
+	// The name of a Secret that contains the SSL certificate of the service.
+	SSLCertificateRef string `json:"sslCertificateRef,omitempty"`
+	// The resource profile of the service.
+	// +kubebuilder:validation:Enum=embedded;performance
+	ResourceProfile string `json:"resourceProfile,omitempty"`
+}
+
+// An EdgefsSWIFTSpec defines the desired state of an EdgefsSWIFT.
+type EdgefsSWIFTSpec struct {
+	xpv1.ResourceSpec     `json:",inline"`
+	EdgefsSWIFTParameters `json:"forProvider"`
+}
+
+// An EdgefsSWIFTStatus defines the current state of an EdgefsSWIFT.
+type EdgefsSWIFTStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EdgefsSWIFT configures a Rook 'swifts.edgefs.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type EdgefsSWIFT struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EdgefsSWIFTSpec   `json:"spec"`
+	Status EdgefsSWIFTStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EdgefsSWIFTList contains a list of EdgefsSWIFT
+type EdgefsSWIFTList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EdgefsSWIFT `json:"items"`
+}
+
+// TargetParametersSpec represents the iSCSI target parameters of an
+// EdgefsISCSI.
+type TargetParametersSpec struct {
+	MaxRecvDataSegmentLength uint `json:"maxRecvDataSegmentLength,omitempty"`
+	DefaultTime2Retain       uint `json:"defaultTime2Retain,omitempty"`
+	DefaultTime2Wait         uint `json:"defaultTime2Wait,omitempty"`
+	FirstBurstLength         uint `json:"firstBurstLength,omitempty"`
+	MaxBurstLength           uint `json:"maxBurstLength,omitempty"`
+	MaxQueueCmd              uint `json:"maxQueueCmd,omitempty"`
+}
+
+// An EdgefsISCSIParameters defines the desired state of an EdgefsISCSI.
+type EdgefsISCSIParameters struct {
+	Name string `json:"name"`
+	// The namespace of the EdgeFS cluster that serves this iSCSI service.
+	Namespace string `json:"namespace,omitempty"`
+	// A reference to the EdgefsCluster that serves this iSCSI service, used
+	// to set its namespace.
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+	// A selector for an EdgefsCluster that serves this iSCSI service, used to
+	// set its namespace.
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+	// The annotations to add/set on each Pod related object.
+	Annotations v1alpha1.Annotations `json:"annotations,omitempty"`
+	// The placement-related configuration to pass to kubernetes (affinity,
+	// tolerations).
+	Placement *v1alpha1.Placement `json:"placement,omitempty"`
+	// Resources set resource requests and limits.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// The number of pods in the iSCSI service.
+	Instances int32 `json:"instances"`
+	// The iSCSI target name.
+	TargetName string `json:"targetName,omitempty"`
+	// The iSCSI target parameters.
+	TargetParams TargetParametersSpec `json:"targetParams,omitempty"`
+	// The resource profile of the service.
+	// +kubebuilder:validation:Enum=embedded;performance
+	ResourceProfile string `json:"resourceProfile,omitempty"`
+}
+
+// An EdgefsISCSISpec defines the desired state of an EdgefsISCSI.
+type EdgefsISCSISpec struct {
+	xpv1.ResourceSpec     `json:",inline"`
+	EdgefsISCSIParameters `json:"forProvider"`
+}
+
+// An EdgefsISCSIStatus defines the current state of an EdgefsISCSI.
+type EdgefsISCSIStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EdgefsISCSI configures a Rook 'iscsis.edgefs.rook.io'
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type EdgefsISCSI struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EdgefsISCSISpec   `json:"spec"`
+	Status EdgefsISCSIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EdgefsISCSIList contains a list of EdgefsISCSI
+type EdgefsISCSIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EdgefsISCSI `json:"items"`
+}
+
+// A CephCSISource identifies the Rook Ceph block pool or filesystem that
+// backs volumes provisioned by the Rook Ceph CSI drivers.
+type CephCSISource struct {
+	// The namespace of the Rook Ceph cluster. It is used as the CSI cluster
+	// ID and is the namespace the CSI secrets are read from.
+	ClusterNamespace string `json:"clusterNamespace"`
+	// The namespace the Rook operator runs in. The operator prefixes the
+	// names of the CSI drivers with it. Defaults to the cluster namespace.
+	OperatorNamespace string `json:"operatorNamespace,omitempty"`
+	// The name of a CephBlockPool in the cluster namespace to provision RBD
+	// volumes from. Exactly one of BlockPool and Filesystem must be set.
+	BlockPool *string `json:"blockPool,omitempty"`
+	// The name of a CephFilesystem in the cluster namespace to provision
+	// CephFS volumes from. Exactly one of BlockPool and Filesystem must be
+	// set.
+	Filesystem *string `json:"filesystem,omitempty"`
+}
+
+// A CephStorageClassParameters defines the desired state of a
+// CephStorageClass.
+type CephStorageClassParameters struct {
+	// The name of the StorageClass.
+	Name string `json:"name"`
+
+	CephCSISource `json:",inline"`
+
+	// The reclaim policy of volumes provisioned from the StorageClass.
+	// Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+	// Whether volumes provisioned from the StorageClass may be expanded.
+	AllowVolumeExpansion *bool `json:"allowVolumeExpansion,omitempty"`
+	// Mount options of volumes provisioned from the StorageClass.
+	MountOptions []string `json:"mountOptions,omitempty"`
+	// When volumes provisioned from the StorageClass are bound. Defaults to
+	// Immediate.
+	// +kubebuilder:validation:Enum=Immediate;WaitForFirstConsumer
+	VolumeBindingMode *storagev1.VolumeBindingMode `json:"volumeBindingMode,omitempty"`
+	// The file system RBD volumes are formatted with, for example 'ext4' or
+	// 'xfs'. Only applies to block pools.
+	FSType string `json:"fsType,omitempty"`
+	// The RBD image features of provisioned volumes. Only applies to block
+	// pools. Defaults to 'layering'.
+	ImageFeatures string `json:"imageFeatures,omitempty"`
+	// The data pool of the filesystem CephFS volumes are stored in. Only
+	// applies to filesystems. Defaults to the first data pool of the
+	// filesystem.
+	DataPool string `json:"dataPool,omitempty"`
+}
+
+// A CephStorageClassSpec defines the desired state of a CephStorageClass.
+type CephStorageClassSpec struct {
+	xpv1.ResourceSpec          `json:",inline"`
+	CephStorageClassParameters `json:"forProvider"`
+}
+
+// A CephStorageClassStatus defines the current state of a CephStorageClass.
+type CephStorageClassStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CephStorageClass configures a 'storageclasses.storage.k8s.io' that
+// provisions volumes from a Rook Ceph block pool or filesystem.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CephStorageClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CephStorageClassSpec   `json:"spec"`
+	Status CephStorageClassStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CephStorageClassList contains a list of CephStorageClass
+type CephStorageClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CephStorageClass `json:"items"`
+}
+
+// A CephVolumeSnapshotClassParameters defines the desired state of a
+// CephVolumeSnapshotClass.
+type CephVolumeSnapshotClassParameters struct {
+	// The name of the VolumeSnapshotClass.
+	Name string `json:"name"`
+	// The namespace of the Rook Ceph cluster. It is used as the CSI cluster
+	// ID and is the namespace the CSI secrets are read from.
+	ClusterNamespace string `json:"clusterNamespace"`
+	// The namespace the Rook operator runs in. The operator prefixes the
+	// names of the CSI drivers with it. Defaults to the cluster namespace.
+	OperatorNamespace string `json:"operatorNamespace,omitempty"`
+	// The name of the CephBlockPool in the cluster namespace whose RBD
+	// volumes are snapshotted. The Rook CSI drivers only support snapshots
+	// of RBD volumes.
+	BlockPool string `json:"blockPool"`
+}
+
+// A CephVolumeSnapshotClassSpec defines the desired state of a
+// CephVolumeSnapshotClass.
+type CephVolumeSnapshotClassSpec struct {
+	xpv1.ResourceSpec                 `json:",inline"`
+	CephVolumeSnapshotClassParameters `json:"forProvider"`
+}
+
+// A CephVolumeSnapshotClassStatus defines the current state of a
+// CephVolumeSnapshotClass.
+type CephVolumeSnapshotClassStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CephVolumeSnapshotClass configures a
+// 'volumesnapshotclasses.snapshot.storage.k8s.io' that snapshots RBD volumes
+// of a Rook Ceph block pool.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,rook}
+type CephVolumeSnapshotClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CephVolumeSnapshotClassSpec   `json:"spec"`
+	Status CephVolumeSnapshotClassStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CephVolumeSnapshotClassList contains a list of CephVolumeSnapshotClass
+type CephVolumeSnapshotClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CephVolumeSnapshotClass `json:"items"`
+}
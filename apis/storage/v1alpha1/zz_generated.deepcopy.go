@@ -0,0 +1,1294 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apisv1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedClientsSpec) DeepCopyInto(out *AllowedClientsSpec) {
+	*out = *in
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedClientsSpec.
+func (in *AllowedClientsSpec) DeepCopy() *AllowedClientsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedClientsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephCSISource) DeepCopyInto(out *CephCSISource) {
+	*out = *in
+	if in.BlockPool != nil {
+		in, out := &in.BlockPool, &out.BlockPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filesystem != nil {
+		in, out := &in.Filesystem, &out.Filesystem
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephCSISource.
+func (in *CephCSISource) DeepCopy() *CephCSISource {
+	if in == nil {
+		return nil
+	}
+	out := new(CephCSISource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephStorageClass) DeepCopyInto(out *CephStorageClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephStorageClass.
+func (in *CephStorageClass) DeepCopy() *CephStorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(CephStorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephStorageClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephStorageClassList) DeepCopyInto(out *CephStorageClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CephStorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephStorageClassList.
+func (in *CephStorageClassList) DeepCopy() *CephStorageClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(CephStorageClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephStorageClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephStorageClassParameters) DeepCopyInto(out *CephStorageClassParameters) {
+	*out = *in
+	in.CephCSISource.DeepCopyInto(&out.CephCSISource)
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(corev1.PersistentVolumeReclaimPolicy)
+		**out = **in
+	}
+	if in.AllowVolumeExpansion != nil {
+		in, out := &in.AllowVolumeExpansion, &out.AllowVolumeExpansion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MountOptions != nil {
+		in, out := &in.MountOptions, &out.MountOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeBindingMode != nil {
+		in, out := &in.VolumeBindingMode, &out.VolumeBindingMode
+		*out = new(storagev1.VolumeBindingMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephStorageClassParameters.
+func (in *CephStorageClassParameters) DeepCopy() *CephStorageClassParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CephStorageClassParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephStorageClassSpec) DeepCopyInto(out *CephStorageClassSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.CephStorageClassParameters.DeepCopyInto(&out.CephStorageClassParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephStorageClassSpec.
+func (in *CephStorageClassSpec) DeepCopy() *CephStorageClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CephStorageClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephStorageClassStatus) DeepCopyInto(out *CephStorageClassStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephStorageClassStatus.
+func (in *CephStorageClassStatus) DeepCopy() *CephStorageClassStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephStorageClassStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephVolumeSnapshotClass) DeepCopyInto(out *CephVolumeSnapshotClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephVolumeSnapshotClass.
+func (in *CephVolumeSnapshotClass) DeepCopy() *CephVolumeSnapshotClass {
+	if in == nil {
+		return nil
+	}
+	out := new(CephVolumeSnapshotClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephVolumeSnapshotClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephVolumeSnapshotClassList) DeepCopyInto(out *CephVolumeSnapshotClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CephVolumeSnapshotClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephVolumeSnapshotClassList.
+func (in *CephVolumeSnapshotClassList) DeepCopy() *CephVolumeSnapshotClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(CephVolumeSnapshotClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephVolumeSnapshotClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephVolumeSnapshotClassParameters) DeepCopyInto(out *CephVolumeSnapshotClassParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephVolumeSnapshotClassParameters.
+func (in *CephVolumeSnapshotClassParameters) DeepCopy() *CephVolumeSnapshotClassParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CephVolumeSnapshotClassParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephVolumeSnapshotClassSpec) DeepCopyInto(out *CephVolumeSnapshotClassSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.CephVolumeSnapshotClassParameters = in.CephVolumeSnapshotClassParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephVolumeSnapshotClassSpec.
+func (in *CephVolumeSnapshotClassSpec) DeepCopy() *CephVolumeSnapshotClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CephVolumeSnapshotClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephVolumeSnapshotClassStatus) DeepCopyInto(out *CephVolumeSnapshotClassStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephVolumeSnapshotClassStatus.
+func (in *CephVolumeSnapshotClassStatus) DeepCopy() *CephVolumeSnapshotClassStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephVolumeSnapshotClassStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsCluster) DeepCopyInto(out *EdgefsCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsCluster.
+func (in *EdgefsCluster) DeepCopy() *EdgefsCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsClusterList) DeepCopyInto(out *EdgefsClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EdgefsCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsClusterList.
+func (in *EdgefsClusterList) DeepCopy() *EdgefsClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsClusterParameters) DeepCopyInto(out *EdgefsClusterParameters) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apisv1alpha1.Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(NetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsClusterParameters.
+func (in *EdgefsClusterParameters) DeepCopy() *EdgefsClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsClusterSpec) DeepCopyInto(out *EdgefsClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.EdgefsClusterParameters.DeepCopyInto(&out.EdgefsClusterParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsClusterSpec.
+func (in *EdgefsClusterSpec) DeepCopy() *EdgefsClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsClusterStatus) DeepCopyInto(out *EdgefsClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsClusterStatus.
+func (in *EdgefsClusterStatus) DeepCopy() *EdgefsClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsISCSI) DeepCopyInto(out *EdgefsISCSI) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsISCSI.
+func (in *EdgefsISCSI) DeepCopy() *EdgefsISCSI {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsISCSI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsISCSI) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsISCSIList) DeepCopyInto(out *EdgefsISCSIList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EdgefsISCSI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsISCSIList.
+func (in *EdgefsISCSIList) DeepCopy() *EdgefsISCSIList {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsISCSIList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsISCSIList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsISCSIParameters) DeepCopyInto(out *EdgefsISCSIParameters) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apisv1alpha1.Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	out.TargetParams = in.TargetParams
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsISCSIParameters.
+func (in *EdgefsISCSIParameters) DeepCopy() *EdgefsISCSIParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsISCSIParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsISCSISpec) DeepCopyInto(out *EdgefsISCSISpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.EdgefsISCSIParameters.DeepCopyInto(&out.EdgefsISCSIParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsISCSISpec.
+func (in *EdgefsISCSISpec) DeepCopy() *EdgefsISCSISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsISCSISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsISCSIStatus) DeepCopyInto(out *EdgefsISCSIStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsISCSIStatus.
+func (in *EdgefsISCSIStatus) DeepCopy() *EdgefsISCSIStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsISCSIStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsNFS) DeepCopyInto(out *EdgefsNFS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsNFS.
+func (in *EdgefsNFS) DeepCopy() *EdgefsNFS {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsNFS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsNFS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsNFSList) DeepCopyInto(out *EdgefsNFSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EdgefsNFS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsNFSList.
+func (in *EdgefsNFSList) DeepCopy() *EdgefsNFSList {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsNFSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsNFSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsNFSParameters) DeepCopyInto(out *EdgefsNFSParameters) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apisv1alpha1.Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsNFSParameters.
+func (in *EdgefsNFSParameters) DeepCopy() *EdgefsNFSParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsNFSParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsNFSSpec) DeepCopyInto(out *EdgefsNFSSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.EdgefsNFSParameters.DeepCopyInto(&out.EdgefsNFSParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsNFSSpec.
+func (in *EdgefsNFSSpec) DeepCopy() *EdgefsNFSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsNFSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsNFSStatus) DeepCopyInto(out *EdgefsNFSStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsNFSStatus.
+func (in *EdgefsNFSStatus) DeepCopy() *EdgefsNFSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsNFSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsS3) DeepCopyInto(out *EdgefsS3) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsS3.
+func (in *EdgefsS3) DeepCopy() *EdgefsS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsS3) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsS3List) DeepCopyInto(out *EdgefsS3List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EdgefsS3, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsS3List.
+func (in *EdgefsS3List) DeepCopy() *EdgefsS3List {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsS3List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsS3List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsS3Parameters) DeepCopyInto(out *EdgefsS3Parameters) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apisv1alpha1.Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsS3Parameters.
+func (in *EdgefsS3Parameters) DeepCopy() *EdgefsS3Parameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsS3Parameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsS3Spec) DeepCopyInto(out *EdgefsS3Spec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.EdgefsS3Parameters.DeepCopyInto(&out.EdgefsS3Parameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsS3Spec.
+func (in *EdgefsS3Spec) DeepCopy() *EdgefsS3Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsS3Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsS3Status) DeepCopyInto(out *EdgefsS3Status) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsS3Status.
+func (in *EdgefsS3Status) DeepCopy() *EdgefsS3Status {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsS3Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsSWIFT) DeepCopyInto(out *EdgefsSWIFT) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsSWIFT.
+func (in *EdgefsSWIFT) DeepCopy() *EdgefsSWIFT {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsSWIFT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsSWIFT) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsSWIFTList) DeepCopyInto(out *EdgefsSWIFTList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EdgefsSWIFT, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsSWIFTList.
+func (in *EdgefsSWIFTList) DeepCopy() *EdgefsSWIFTList {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsSWIFTList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EdgefsSWIFTList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsSWIFTParameters) DeepCopyInto(out *EdgefsSWIFTParameters) {
+	*out = *in
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apisv1alpha1.Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsSWIFTParameters.
+func (in *EdgefsSWIFTParameters) DeepCopy() *EdgefsSWIFTParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsSWIFTParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsSWIFTSpec) DeepCopyInto(out *EdgefsSWIFTSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.EdgefsSWIFTParameters.DeepCopyInto(&out.EdgefsSWIFTParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsSWIFTSpec.
+func (in *EdgefsSWIFTSpec) DeepCopy() *EdgefsSWIFTSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsSWIFTSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsSWIFTStatus) DeepCopyInto(out *EdgefsSWIFTStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsSWIFTStatus.
+func (in *EdgefsSWIFTStatus) DeepCopy() *EdgefsSWIFTStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsSWIFTStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EdgefsStorageSpec) DeepCopyInto(out *EdgefsStorageSpec) {
+	*out = *in
+	if in.UseAllDevices != nil {
+		in, out := &in.UseAllDevices, &out.UseAllDevices
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Directories != nil {
+		in, out := &in.Directories, &out.Directories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdgefsStorageSpec.
+func (in *EdgefsStorageSpec) DeepCopy() *EdgefsStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EdgefsStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportsSpec) DeepCopyInto(out *ExportsSpec) {
+	*out = *in
+	in.Server.DeepCopyInto(&out.Server)
+	out.PersistentVolumeClaim = in.PersistentVolumeClaim
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportsSpec.
+func (in *ExportsSpec) DeepCopy() *ExportsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinioObjectStore) DeepCopyInto(out *MinioObjectStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinioObjectStore.
+func (in *MinioObjectStore) DeepCopy() *MinioObjectStore {
+	if in == nil {
+		return nil
+	}
+	out := new(MinioObjectStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MinioObjectStore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinioObjectStoreList) DeepCopyInto(out *MinioObjectStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MinioObjectStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinioObjectStoreList.
+func (in *MinioObjectStoreList) DeepCopy() *MinioObjectStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(MinioObjectStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MinioObjectStoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinioObjectStoreParameters) DeepCopyInto(out *MinioObjectStoreParameters) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	out.Credentials = in.Credentials
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinioObjectStoreParameters.
+func (in *MinioObjectStoreParameters) DeepCopy() *MinioObjectStoreParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MinioObjectStoreParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinioObjectStoreSpec) DeepCopyInto(out *MinioObjectStoreSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.MinioObjectStoreParameters.DeepCopyInto(&out.MinioObjectStoreParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinioObjectStoreSpec.
+func (in *MinioObjectStoreSpec) DeepCopy() *MinioObjectStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MinioObjectStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinioObjectStoreStatus) DeepCopyInto(out *MinioObjectStoreStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinioObjectStoreStatus.
+func (in *MinioObjectStoreStatus) DeepCopy() *MinioObjectStoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MinioObjectStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSServer) DeepCopyInto(out *NFSServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSServer.
+func (in *NFSServer) DeepCopy() *NFSServer {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NFSServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSServerList) DeepCopyInto(out *NFSServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NFSServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSServerList.
+func (in *NFSServerList) DeepCopy() *NFSServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NFSServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSServerParameters) DeepCopyInto(out *NFSServerParameters) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(apisv1alpha1.Annotations, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Exports != nil {
+		in, out := &in.Exports, &out.Exports
+		*out = make([]ExportsSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSServerParameters.
+func (in *NFSServerParameters) DeepCopy() *NFSServerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSServerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSServerSpec) DeepCopyInto(out *NFSServerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.NFSServerParameters.DeepCopyInto(&out.NFSServerParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSServerSpec.
+func (in *NFSServerSpec) DeepCopy() *NFSServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSServerStatus) DeepCopyInto(out *NFSServerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSServerStatus.
+func (in *NFSServerStatus) DeepCopy() *NFSServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSpec) DeepCopyInto(out *ServerSpec) {
+	*out = *in
+	if in.AllowedClients != nil {
+		in, out := &in.AllowedClients, &out.AllowedClients
+		*out = make([]AllowedClientsSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSpec.
+func (in *ServerSpec) DeepCopy() *ServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetParametersSpec) DeepCopyInto(out *TargetParametersSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetParametersSpec.
+func (in *TargetParametersSpec) DeepCopy() *TargetParametersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetParametersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
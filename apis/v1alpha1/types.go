@@ -30,3 +30,29 @@ type StorageScopeSpec struct {
 
 // Annotations are a Crossplane representation of Rook Annotations.
 type Annotations map[string]string
+
+// Placement is a Crossplane representation of a Rook Placement. It describes
+// the affinity and tolerations used to schedule the Pods of a Rook resource.
+type Placement struct {
+	NodeAffinity    *v1.NodeAffinity    `json:"nodeAffinity,omitempty"`
+	PodAffinity     *v1.PodAffinity     `json:"podAffinity,omitempty"`
+	PodAntiAffinity *v1.PodAntiAffinity `json:"podAntiAffinity,omitempty"`
+	Tolerations     []v1.Toleration     `json:"tolerations,omitempty"`
+}
+
+// A VolumeClaimObservation reflects the observed state of a
+// PersistentVolumeClaim that stores the data of a cluster.
+type VolumeClaimObservation struct {
+	// Name of the PersistentVolumeClaim.
+	Name string `json:"name"`
+
+	// Requested storage of the PersistentVolumeClaim.
+	Requested string `json:"requested,omitempty"`
+
+	// Capacity of the volume bound to the PersistentVolumeClaim.
+	Capacity string `json:"capacity,omitempty"`
+
+	// Resize is the condition of a volume that is being expanded, either
+	// Resizing or FileSystemResizePending.
+	Resize string `json:"resize,omitempty"`
+}
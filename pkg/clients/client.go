@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -61,6 +62,26 @@ func NewClient(ctx context.Context, c client.Client, mg resource.Managed, s *run
 
 // UseProviderConfig to create a client.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, s *runtime.Scheme) (client.Client, error) {
+	cfg, err := NewRESTConfig(ctx, c, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	kc, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return kc, nil
+}
+
+// NewRESTConfig returns a rest config for the Kubernetes cluster described by
+// the ProviderConfig referenced by the given managed resource.
+func NewRESTConfig(ctx context.Context, c client.Client, mg resource.Managed) (*rest.Config, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return nil, errors.New(errNoRefGiven)
+	}
+
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, errGetProviderConfig)
@@ -72,12 +93,12 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	}
 
 	if s := pc.Spec.Credentials.Source; s != xpv1.CredentialsSourceSecret {
-		return c, errors.Errorf(errFmtUnsupportedCredSource, s)
+		return nil, errors.Errorf(errFmtUnsupportedCredSource, s)
 	}
 
 	ref := pc.Spec.Credentials.SecretRef
 	if ref == nil {
-		return c, errors.New(errNoSecretRef)
+		return nil, errors.New(errNoSecretRef)
 	}
 
 	secret := &corev1.Secret{}
@@ -90,14 +111,5 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, errConstructClientConfig)
 	}
 	restCfg, err := cfg.ClientConfig()
-	if err != nil {
-		return nil, errors.Wrap(err, errConstructRestConfig)
-	}
-
-	kc, err := client.New(restCfg, client.Options{Scheme: s})
-	if err != nil {
-		return nil, errors.Wrap(err, errNewClient)
-	}
-
-	return kc, nil
+	return restCfg, errors.Wrap(err, errConstructRestConfig)
 }
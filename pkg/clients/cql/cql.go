@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cql contains a client for databases that speak the Cassandra Query
+// Language, such as the YCQL API of YugabyteDB.
+package cql
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/gocql/gocql"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+// A DB runs statements against a database that speaks the Cassandra Query
+// Language.
+type DB interface {
+	// Exec runs the supplied statement.
+	Exec(ctx context.Context, stmt string, args ...interface{}) error
+
+	// Scan copies the columns of the single row returned by the supplied
+	// query into the supplied destinations. It returns an error for which
+	// IsNotFound returns true if the query returns no rows.
+	Scan(ctx context.Context, query string, dest []interface{}, args ...interface{}) error
+
+	// Rows returns each row returned by the supplied query as a map of column
+	// names to values.
+	Rows(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error)
+}
+
+// IsNotFound returns true if the supplied error indicates that a query
+// returned no rows.
+func IsNotFound(err error) bool {
+	return err == gocql.ErrNotFound
+}
+
+// A Connection describes how to connect to a database. The user and password
+// are omitted when the database does not require authentication.
+type Connection struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+}
+
+// UserConnectionDetails returns the connection details of the supplied user of
+// the supplied connection.
+func UserConnectionDetails(c Connection, user string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(c.Host),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(c.Port),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+	}
+}
+
+// QuoteIdentifier quotes the supplied identifier for use in a statement.
+func QuoteIdentifier(id string) string {
+	return `"` + strings.ReplaceAll(id, `"`, `""`) + `"`
+}
+
+// QuoteLiteral quotes the supplied string literal for use in a statement.
+func QuoteLiteral(l string) string {
+	return "'" + strings.ReplaceAll(l, "'", "''") + "'"
+}
+
+// A cassandraDB is a DB that connects using the gocql driver. A session is
+// opened and closed for each call, because managed resource controllers
+// connect to a different database for each reconcile.
+type cassandraDB struct {
+	cluster *gocql.ClusterConfig
+}
+
+// New returns a DB that connects using the supplied connection.
+func New(c Connection) DB {
+	cluster := gocql.NewCluster(net.JoinHostPort(c.Host, c.Port))
+	if c.User != "" {
+		cluster.Authenticator = gocql.PasswordAuthenticator{Username: c.User, Password: c.Password}
+	}
+	return &cassandraDB{cluster: cluster}
+}
+
+func (c *cassandraDB) Exec(ctx context.Context, stmt string, args ...interface{}) error {
+	s, err := c.cluster.CreateSession()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	return s.Query(stmt, args...).WithContext(ctx).Exec()
+}
+
+func (c *cassandraDB) Scan(ctx context.Context, query string, dest []interface{}, args ...interface{}) error {
+	s, err := c.cluster.CreateSession()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	return s.Query(query, args...).WithContext(ctx).Scan(dest...)
+}
+
+func (c *cassandraDB) Rows(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	s, err := c.cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+
+	return s.Query(query, args...).WithContext(ctx).Iter().SliceMap()
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cql
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+func TestUserConnectionDetails(t *testing.T) {
+	conn := Connection{Host: "cool-host", Port: "9042"}
+	want := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-host"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("9042"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("cool-user"),
+	}
+
+	got := UserConnectionDetails(conn, "cool-user")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UserConnectionDetails(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	cases := map[string]struct {
+		fn   func(string) string
+		in   string
+		want string
+	}{
+		"Identifier": {
+			fn:   QuoteIdentifier,
+			in:   "cool-keyspace",
+			want: `"cool-keyspace"`,
+		},
+		"IdentifierWithQuote": {
+			fn:   QuoteIdentifier,
+			in:   `cool"keyspace`,
+			want: `"cool""keyspace"`,
+		},
+		"Literal": {
+			fn:   QuoteLiteral,
+			in:   "cool-password",
+			want: "'cool-password'",
+		},
+		"LiteralWithQuote": {
+			fn:   QuoteLiteral,
+			in:   "cool'password",
+			want: "'cool''password'",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.fn(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Quote(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cql
+
+import "context"
+
+// A MockDB is a DB whose methods are supplied as functions.
+type MockDB struct {
+	MockExec func(ctx context.Context, stmt string, args ...interface{}) error
+	MockScan func(ctx context.Context, query string, dest []interface{}, args ...interface{}) error
+	MockRows func(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error)
+}
+
+// Exec calls MockExec.
+func (m *MockDB) Exec(ctx context.Context, stmt string, args ...interface{}) error {
+	return m.MockExec(ctx, stmt, args...)
+}
+
+// Scan calls MockScan.
+func (m *MockDB) Scan(ctx context.Context, query string, dest []interface{}, args ...interface{}) error {
+	return m.MockScan(ctx, query, dest, args...)
+}
+
+// Rows calls MockRows.
+func (m *MockDB) Rows(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	return m.MockRows(ctx, query, args...)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cassandra
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/cassandra.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+// CQLPort is the port on which the Rook Cassandra operator exposes CQL.
+const CQLPort = 9042
+
+// CrossToRook converts a Crossplane Cassandra cluster object to a Rook
+// Cassandra cluster object.
+func CrossToRook(c *v1alpha1.CassandraCluster) *rookv1alpha1.Cluster {
+	params := c.Spec.CassandraClusterParameters
+	return &rookv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1alpha1.ClusterSpec{
+			Annotations:  rook.Annotations(params.Annotations),
+			Version:      params.Version,
+			Repository:   params.Repository,
+			Mode:         rookv1alpha1.ClusterMode(params.Mode),
+			Datacenter:   convertDatacenter(params.Datacenter),
+			SidecarImage: convertImage(params.SidecarImage),
+		},
+	}
+}
+
+// NeedsUpdate determines whether the external Rook Cassandra cluster needs to
+// be updated.
+func NeedsUpdate(c *v1alpha1.CassandraCluster, e *rookv1alpha1.Cluster) bool {
+	params := c.Spec.CassandraClusterParameters
+	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if params.Version != e.Spec.Version {
+		return true
+	}
+	if !reflect.DeepEqual(params.Repository, e.Spec.Repository) {
+		return true
+	}
+	if rookv1alpha1.ClusterMode(params.Mode) != e.Spec.Mode {
+		return true
+	}
+	if !reflect.DeepEqual(convertDatacenter(params.Datacenter), e.Spec.Datacenter) {
+		return true
+	}
+	if !reflect.DeepEqual(convertImage(params.SidecarImage), e.Spec.SidecarImage) {
+		return true
+	}
+	return false
+}
+
+// IsAvailable determines whether every rack of the external Rook Cassandra
+// cluster has all of its members ready.
+func IsAvailable(c *v1alpha1.CassandraCluster, e *rookv1alpha1.Cluster) bool {
+	for _, r := range c.Spec.CassandraClusterParameters.Datacenter.Racks {
+		s, ok := e.Status.Racks[r.Name]
+		if !ok || s == nil || s.ReadyMembers < r.Members {
+			return false
+		}
+	}
+	return true
+}
+
+// ConnectionDetails returns the connection details of the CQL endpoint of
+// the supplied Cassandra cluster.
+func ConnectionDetails(c *v1alpha1.CassandraCluster) managed.ConnectionDetails {
+	params := c.Spec.CassandraClusterParameters
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(fmt.Sprintf("%s-client.%s.svc", params.Name, params.Namespace)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(CQLPort)),
+	}
+}
+
+func convertDatacenter(dc v1alpha1.DatacenterSpec) rookv1alpha1.DatacenterSpec {
+	racks := make([]rookv1alpha1.RackSpec, len(dc.Racks))
+	for i, r := range dc.Racks {
+		racks[i] = rookv1alpha1.RackSpec{
+			Name:          r.Name,
+			Members:       r.Members,
+			ConfigMapName: r.ConfigMapName,
+			Storage: rook.StorageScopeSpec{
+				NodeCount: r.Storage.NodeCount,
+				Selection: rook.Selection{
+					VolumeClaimTemplates: r.Storage.VolumeClaimTemplates,
+				},
+			},
+			Annotations: rook.Annotations(r.Annotations),
+			Placement:   convertPlacement(r.Placement),
+			Resources:   r.Resources,
+		}
+	}
+	return rookv1alpha1.DatacenterSpec{
+		Name:  dc.Name,
+		Racks: racks,
+	}
+}
+
+func convertPlacement(p *corev1alpha1.Placement) *rook.Placement {
+	if p == nil {
+		return nil
+	}
+	return &rook.Placement{
+		NodeAffinity:    p.NodeAffinity,
+		PodAffinity:     p.PodAffinity,
+		PodAntiAffinity: p.PodAntiAffinity,
+		Tolerations:     p.Tolerations,
+	}
+}
+
+func convertImage(i *v1alpha1.ImageSpec) *rookv1alpha1.ImageSpec {
+	if i == nil {
+		return nil
+	}
+	return &rookv1alpha1.ImageSpec{
+		Version:    i.Version,
+		Repository: i.Repository,
+	}
+}
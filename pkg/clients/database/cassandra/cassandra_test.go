@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cassandra
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/cassandra.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+
+	providerName         = "cool-rook"
+	connectionSecretName = "cool-connection-secret"
+
+	rackName = "cool-rack"
+)
+
+type cassandraClusterModifier func(*v1alpha1.CassandraCluster)
+
+func withCassandraMembers(m int32) cassandraClusterModifier {
+	return func(i *v1alpha1.CassandraCluster) {
+		i.Spec.CassandraClusterParameters.Datacenter.Racks[0].Members = m
+	}
+}
+
+func withCassandraPlacement(p *corev1alpha1.Placement) cassandraClusterModifier {
+	return func(i *v1alpha1.CassandraCluster) {
+		i.Spec.CassandraClusterParameters.Datacenter.Racks[0].Placement = p
+	}
+}
+
+func cassandraCluster(im ...cassandraClusterModifier) *v1alpha1.CassandraCluster {
+	i := &v1alpha1.CassandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CassandraClusterSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			CassandraClusterParameters: v1alpha1.CassandraClusterParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Version:     "3.11.1",
+				Mode:        "cassandra",
+				Datacenter: v1alpha1.DatacenterSpec{
+					Name: "cool-dc",
+					Racks: []v1alpha1.RackSpec{{
+						Name:    rackName,
+						Members: 3,
+						Storage: corev1alpha1.StorageScopeSpec{
+							VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+								{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: "rook-cassandra-test",
+									},
+									Spec: corev1.PersistentVolumeClaimSpec{
+										AccessModes: []corev1.PersistentVolumeAccessMode{"ReadWriteOnce"},
+										// Test does not check resource requirements due cmp pkg
+										// inability to compare unexported fields.
+										Resources: corev1.ResourceRequirements{},
+									},
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookCassandraClusterModifier func(*rookv1alpha1.Cluster)
+
+func withMembers(m int32) rookCassandraClusterModifier {
+	return func(c *rookv1alpha1.Cluster) { c.Spec.Datacenter.Racks[0].Members = m }
+}
+
+func withPlacement(p *rook.Placement) rookCassandraClusterModifier {
+	return func(c *rookv1alpha1.Cluster) { c.Spec.Datacenter.Racks[0].Placement = p }
+}
+
+func withRackStatus(s *rookv1alpha1.RackStatus) rookCassandraClusterModifier {
+	return func(c *rookv1alpha1.Cluster) {
+		c.Status.Racks = map[string]*rookv1alpha1.RackStatus{rackName: s}
+	}
+}
+
+func rookCassandraCluster(im ...rookCassandraClusterModifier) *rookv1alpha1.Cluster {
+	i := &rookv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1alpha1.ClusterSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Version:     "3.11.1",
+			Mode:        rookv1alpha1.ClusterModeCassandra,
+			Datacenter: rookv1alpha1.DatacenterSpec{
+				Name: "cool-dc",
+				Racks: []rookv1alpha1.RackSpec{{
+					Name:    rackName,
+					Members: 3,
+					Storage: rook.StorageScopeSpec{
+						Selection: rook.Selection{
+							VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+								{
+									ObjectMeta: metav1.ObjectMeta{
+										Name: "rook-cassandra-test",
+									},
+									Spec: corev1.PersistentVolumeClaimSpec{
+										AccessModes: []corev1.PersistentVolumeAccessMode{"ReadWriteOnce"},
+										// Test does not check resource requirements due cmp pkg
+										// inability to compare unexported fields.
+										Resources: corev1.ResourceRequirements{},
+									},
+								},
+							},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestCrossToRook(t *testing.T) {
+	tolerations := []corev1.Toleration{{Key: "cool-key", Operator: corev1.TolerationOpExists}}
+
+	cases := map[string]struct {
+		c    *v1alpha1.CassandraCluster
+		want *rookv1alpha1.Cluster
+	}{
+		"Successful": {
+			c:    cassandraCluster(),
+			want: rookCassandraCluster(),
+		},
+		"SuccessfulWithModifier": {
+			c:    cassandraCluster(withCassandraMembers(5)),
+			want: rookCassandraCluster(withMembers(5)),
+		},
+		"SuccessfulWithPlacement": {
+			c:    cassandraCluster(withCassandraPlacement(&corev1alpha1.Placement{Tolerations: tolerations})),
+			want: rookCassandraCluster(withPlacement(&rook.Placement{Tolerations: tolerations})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CassandraCluster
+		r    *rookv1alpha1.Cluster
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    cassandraCluster(),
+			r:    rookCassandraCluster(),
+			want: false,
+		},
+		"NoUpdateNeededStatusChanged": {
+			c:    cassandraCluster(),
+			r:    rookCassandraCluster(withRackStatus(&rookv1alpha1.RackStatus{Members: 3})),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    cassandraCluster(),
+			r:    rookCassandraCluster(withMembers(5)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAvailable(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CassandraCluster
+		r    *rookv1alpha1.Cluster
+		want bool
+	}{
+		"NoRackStatus": {
+			c:    cassandraCluster(),
+			r:    rookCassandraCluster(),
+			want: false,
+		},
+		"RackNotReady": {
+			c:    cassandraCluster(),
+			r:    rookCassandraCluster(withRackStatus(&rookv1alpha1.RackStatus{Members: 3, ReadyMembers: 2})),
+			want: false,
+		},
+		"RackReady": {
+			c:    cassandraCluster(),
+			r:    rookCassandraCluster(withRackStatus(&rookv1alpha1.RackStatus{Members: 3, ReadyMembers: 3})),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAvailable(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAvailable(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CassandraCluster
+		want managed.ConnectionDetails
+	}{
+		"Successful": {
+			c: cassandraCluster(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-name-client.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("9042"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,15 +17,30 @@ limitations under the License.
 package cockroach
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
 
 	rookv1alpha1 "github.com/rook/rook/pkg/apis/cockroachdb.rook.io/v1alpha1"
 	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
 )
 
+// Values used by the Rook CockroachDB operator.
+const (
+	StatefulSetName   = "rook-cockroachdb"
+	PublicServiceName = "cockroachdb-public"
+	GRPCPortName      = "grpc"
+	DefaultGRPCPort   = 26257
+	RootUser          = "root"
+)
+
 // CrossToRook converts a Crossplane Yugabyte cluster object to a Rook Yugabyte
 // cluster object.
 func CrossToRook(c *v1alpha1.CockroachCluster) *rookv1alpha1.Cluster {
@@ -54,7 +69,9 @@ func CrossToRook(c *v1alpha1.CockroachCluster) *rookv1alpha1.Cluster {
 }
 
 // NeedsUpdate determines whether the external Rook Cockroach cluster needs to be
-// updated.
+// updated. Unlike those of a Rook Yugabyte cluster, volume claim templates are
+// compared, because the operator ignores updates to a cluster rather than
+// copying them to its StatefulSet.
 func NeedsUpdate(c *v1alpha1.CockroachCluster, e *rookv1alpha1.Cluster) bool {
 	params := c.Spec.CockroachClusterParameters
 	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
@@ -78,6 +95,39 @@ func NeedsUpdate(c *v1alpha1.CockroachCluster, e *rookv1alpha1.Cluster) bool {
 	return false
 }
 
+// VolumeClaimNames returns the names of the volume claims that the StatefulSet
+// of the supplied CockroachDB cluster creates from the supplied volume claim
+// template.
+func VolumeClaimNames(c *v1alpha1.CockroachCluster, tmpl corev1.PersistentVolumeClaim) []string {
+	names := make([]string, c.Spec.CockroachClusterParameters.Storage.NodeCount)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%s-%d", tmpl.GetName(), StatefulSetName, i)
+	}
+	return names
+}
+
+// ConnectionDetails returns the connection details of the SQL endpoint of the
+// supplied CockroachDB cluster.
+func ConnectionDetails(c *v1alpha1.CockroachCluster) managed.ConnectionDetails {
+	params := c.Spec.CockroachClusterParameters
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(fmt.Sprintf("%s.%s.svc", PublicServiceName, params.Namespace)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(grpcPort(params.Network.Ports)))),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(RootUser),
+	}
+}
+
+// grpcPort returns the port on which the cluster serves both gRPC and SQL
+// clients.
+func grpcPort(ports []v1alpha1.PortSpec) int32 {
+	for _, p := range ports {
+		if p.Name == GRPCPortName {
+			return p.Port
+		}
+	}
+	return DefaultGRPCPort
+}
+
 func convertPorts(ports []v1alpha1.PortSpec) []rookv1alpha1.PortSpec {
 	rookports := make([]rookv1alpha1.PortSpec, len(ports))
 	for i, p := range ports {
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
 	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
@@ -237,6 +238,19 @@ func TestNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestVolumeClaimNames(t *testing.T) {
+	c := cockroachCluster()
+	want := []string{
+		"rook-cockroachdb-test-rook-cockroachdb-0",
+		"rook-cockroachdb-test-rook-cockroachdb-1",
+		"rook-cockroachdb-test-rook-cockroachdb-2",
+	}
+	got := VolumeClaimNames(c, c.Spec.CockroachClusterParameters.Storage.VolumeClaimTemplates[0])
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("VolumeClaimNames(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestConvertPorts(t *testing.T) {
 	n := "cool-port"
 
@@ -263,3 +277,40 @@ func TestConvertPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionDetails(t *testing.T) {
+	grpc := func(i *v1alpha1.CockroachCluster) {
+		i.Spec.CockroachClusterParameters.Network.Ports = []v1alpha1.PortSpec{{Name: GRPCPortName, Port: 26258}}
+	}
+
+	cases := map[string]struct {
+		c    *v1alpha1.CockroachCluster
+		want managed.ConnectionDetails
+	}{
+		"DefaultPort": {
+			c: cockroachCluster(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("cockroachdb-public.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("26257"),
+				xpv1.ResourceCredentialsSecretUserKey:     []byte("root"),
+			},
+		},
+		"GRPCPort": {
+			c: cockroachCluster(grpc),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("cockroachdb-public.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("26258"),
+				xpv1.ResourceCredentialsSecretUserKey:     []byte("root"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
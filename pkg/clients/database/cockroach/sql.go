@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cockroach
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errNoCluster            = "no CockroachCluster is referenced"
+	errGetCluster           = "cannot get referenced CockroachCluster"
+	errNoConnectionSecret   = "referenced CockroachCluster does not write a connection secret"
+	errGetConnectionSecret  = "cannot get connection secret of referenced CockroachCluster"
+	errParseRestoreURI      = "cannot parse backup URI"
+	errGetCredentialsSecret = "cannot get backup credentials secret"
+)
+
+// GetConnection returns the SQL connection to the referenced CockroachCluster,
+// as published to its connection secret.
+func GetConnection(ctx context.Context, c client.Reader, r v1alpha1.CockroachClusterReference) (pgwire.Connection, error) {
+	if r.Cluster == "" {
+		return pgwire.Connection{}, errors.New(errNoCluster)
+	}
+
+	cc := &v1alpha1.CockroachCluster{}
+	if err := c.Get(ctx, types.NamespacedName{Name: r.Cluster}, cc); err != nil {
+		return pgwire.Connection{}, errors.Wrap(err, errGetCluster)
+	}
+
+	ref := cc.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return pgwire.Connection{}, errors.New(errNoConnectionSecret)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return pgwire.Connection{}, errors.Wrap(err, errGetConnectionSecret)
+	}
+
+	return pgwire.ConnectionFrom(s.Data, sslMode(cc)), nil
+}
+
+// Connection returns the SQL connection to the supplied CockroachCluster, as
+// it would be published to its connection secret.
+func Connection(cc *v1alpha1.CockroachCluster) pgwire.Connection {
+	return pgwire.ConnectionFrom(ConnectionDetails(cc), sslMode(cc))
+}
+
+// RestoreURI returns the URI of the supplied backup, with the credentials it
+// references added as query parameters.
+func RestoreURI(ctx context.Context, c client.Reader, r v1alpha1.CockroachRestoreSource) (string, error) {
+	u, err := url.Parse(r.URI)
+	if err != nil {
+		return "", errors.Wrap(err, errParseRestoreURI)
+	}
+
+	ref := r.CredentialsSecretRef
+	if ref == nil {
+		return u.String(), nil
+	}
+
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetCredentialsSecret)
+	}
+
+	q := u.Query()
+	for k, v := range s.Data {
+		q.Set(k, string(v))
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// sslMode returns the SSL mode used to connect to the supplied
+// CockroachCluster. The Rook CockroachDB operator initializes clusters in
+// insecure mode, which does not support TLS.
+func sslMode(cc *v1alpha1.CockroachCluster) string {
+	if cc.Spec.CockroachClusterParameters.Secure {
+		return pgwire.SSLModeRequire
+	}
+	return pgwire.SSLModeDisable
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cockroach
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+var errBoom = errors.New("boom")
+
+func connectionSecretData() map[string][]byte {
+	return map[string][]byte{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("cockroachdb-public.cool-namespace.svc"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("26257"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("root"),
+	}
+}
+
+func TestGetConnection(t *testing.T) {
+	ref := v1alpha1.CockroachClusterReference{Cluster: name}
+	secure := func(i *v1alpha1.CockroachCluster) { i.Spec.CockroachClusterParameters.Secure = true }
+	noSecret := func(i *v1alpha1.CockroachCluster) { i.Spec.WriteConnectionSecretToReference = nil }
+
+	type want struct {
+		c   pgwire.Connection
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		ref  v1alpha1.CockroachClusterReference
+		want want
+	}{
+		"Insecure": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha1.CockroachCluster:
+						*o = *cockroachCluster()
+					case *corev1.Secret:
+						o.Data = connectionSecretData()
+					}
+					return nil
+				},
+			},
+			ref: ref,
+			want: want{
+				c: pgwire.Connection{
+					Host:    "cockroachdb-public.cool-namespace.svc",
+					Port:    "26257",
+					User:    "root",
+					SSLMode: pgwire.SSLModeDisable,
+				},
+			},
+		},
+		"Secure": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha1.CockroachCluster:
+						*o = *cockroachCluster(secure)
+					case *corev1.Secret:
+						o.Data = connectionSecretData()
+						o.Data[xpv1.ResourceCredentialsSecretPasswordKey] = []byte("cool-password")
+					}
+					return nil
+				},
+			},
+			ref: ref,
+			want: want{
+				c: pgwire.Connection{
+					Host:     "cockroachdb-public.cool-namespace.svc",
+					Port:     "26257",
+					User:     "root",
+					Password: "cool-password",
+					SSLMode:  pgwire.SSLModeRequire,
+				},
+			},
+		},
+		"NoCluster": {
+			ref: v1alpha1.CockroachClusterReference{},
+			want: want{
+				err: errors.New(errNoCluster),
+			},
+		},
+		"GetClusterError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			ref: ref,
+			want: want{
+				err: errors.Wrap(errBoom, errGetCluster),
+			},
+		},
+		"NoConnectionSecret": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*v1alpha1.CockroachCluster) = *cockroachCluster(noSecret)
+					return nil
+				},
+			},
+			ref: ref,
+			want: want{
+				err: errors.New(errNoConnectionSecret),
+			},
+		},
+		"GetConnectionSecretError": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if o, ok := obj.(*v1alpha1.CockroachCluster); ok {
+						*o = *cockroachCluster()
+						return nil
+					}
+					return errBoom
+				},
+			},
+			ref: ref,
+			want: want{
+				err: errors.Wrap(errBoom, errGetConnectionSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetConnection(context.Background(), tc.kube, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetConnection(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("GetConnection(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRestoreURI(t *testing.T) {
+	creds := &xpv1.SecretReference{Name: "cool-creds", Namespace: namespace}
+
+	type want struct {
+		uri string
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		r    v1alpha1.CockroachRestoreSource
+		want want
+	}{
+		"NoCredentials": {
+			r: v1alpha1.CockroachRestoreSource{URI: "nodelocal:///backups/cool"},
+			want: want{
+				uri: "nodelocal:///backups/cool",
+			},
+		},
+		"Credentials": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{
+						"AWS_ACCESS_KEY_ID":     []byte("cool-id"),
+						"AWS_SECRET_ACCESS_KEY": []byte("cool/secret+key"),
+					}
+					return nil
+				},
+			},
+			r: v1alpha1.CockroachRestoreSource{URI: "s3://cool-bucket/backups?AWS_REGION=us-east-1", CredentialsSecretRef: creds},
+			want: want{
+				uri: "s3://cool-bucket/backups?AWS_ACCESS_KEY_ID=cool-id&AWS_REGION=us-east-1&AWS_SECRET_ACCESS_KEY=cool%2Fsecret%2Bkey",
+			},
+		},
+		"GetCredentialsSecretError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			r: v1alpha1.CockroachRestoreSource{URI: "s3://cool-bucket/backups", CredentialsSecretRef: creds},
+			want: want{
+				err: errors.Wrap(errBoom, errGetCredentialsSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RestoreURI(context.Background(), tc.kube, tc.r)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("RestoreURI(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.uri, got); diff != "" {
+				t.Errorf("RestoreURI(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yugabyte
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+)
+
+// Error strings.
+const (
+	errNoLoadMoveCompletion = "cannot find percent complete in yb-admin output"
+
+	errFmtEvenMasters  = "cannot change master replicas from %d to %d: an even number of masters tolerates no more failures than one fewer"
+	errFmtMasterQuorum = "cannot change master replicas from %d to %d: the remaining masters would not form a quorum"
+)
+
+// AdminCommand is the path of yb-admin in the YugabyteDB image.
+const AdminCommand = "/home/yugabyte/bin/yb-admin"
+
+// Blacklist operations understood by yb-admin.
+const (
+	BlacklistAdd    = "ADD"
+	BlacklistRemove = "REMOVE"
+)
+
+var loadMoveCompletion = regexp.MustCompile(`Percent complete = ([0-9.]+)`)
+
+// MasterPod returns the name of the first master pod of the supplied Yugabyte
+// cluster, in which yb-admin commands are run.
+func MasterPod(c *v1alpha1.YugabyteCluster) string {
+	return fmt.Sprintf("%s-%s-0", MasterName, c.Spec.YugabyteClusterParameters.Name)
+}
+
+// TServerAddresses returns the RPC addresses that the tservers with ordinals
+// from up to but not including to broadcast to the masters of the supplied
+// Yugabyte cluster.
+func TServerAddresses(c *v1alpha1.YugabyteCluster, from, to int32) []string {
+	params := c.Spec.YugabyteClusterParameters
+	p := port(params.TServer.Network.Ports, TServerRPCPortName, DefaultTServerRPCPort)
+	addrs := make([]string, 0, to-from)
+	for i := from; i < to; i++ {
+		addrs = append(addrs, fmt.Sprintf("%s-%s-%d.%s-%s:%d", TServerName, params.Name, i, TServerServiceName, params.Name, p))
+	}
+	return addrs
+}
+
+// ChangeBlacklistCommand returns the yb-admin command that adds the supplied
+// tserver addresses to, or removes them from, the blacklist of the supplied
+// Yugabyte cluster. The masters move data off blacklisted tservers.
+func ChangeBlacklistCommand(c *v1alpha1.YugabyteCluster, op string, addrs []string) []string {
+	return append([]string{AdminCommand, "--master_addresses", masterAddresses(c), "change_blacklist", op}, addrs...)
+}
+
+// LoadMoveCompletionCommand returns the yb-admin command that reports how
+// much of the data on blacklisted tservers has been moved off them.
+func LoadMoveCompletionCommand(c *v1alpha1.YugabyteCluster) []string {
+	return []string{AdminCommand, "--master_addresses", masterAddresses(c), "get_load_move_completion"}
+}
+
+// ParseLoadMoveCompletion returns the percentage reported by the command
+// returned by LoadMoveCompletionCommand.
+func ParseLoadMoveCompletion(out string) (float64, error) {
+	m := loadMoveCompletion.FindStringSubmatch(out)
+	if m == nil {
+		return 0, errors.New(errNoLoadMoveCompletion)
+	}
+	return strconv.ParseFloat(m[1], 64)
+}
+
+// ValidateMasterReplicas returns an error if changing the number of masters
+// of a Yugabyte cluster from current to desired would weaken the Raft group
+// they form. Masters that are removed are not removed from the group.
+func ValidateMasterReplicas(current, desired int32) error {
+	switch {
+	case desired == current:
+		return nil
+	case desired%2 == 0:
+		return errors.Errorf(errFmtEvenMasters, current, desired)
+	case desired*2 <= current:
+		return errors.Errorf(errFmtMasterQuorum, current, desired)
+	}
+	return nil
+}
+
+// masterAddresses returns the RPC address of the masters of the supplied
+// Yugabyte cluster. Like the tservers, yb-admin resolves the headless service
+// of the masters to the address of each master.
+func masterAddresses(c *v1alpha1.YugabyteCluster) string {
+	params := c.Spec.YugabyteClusterParameters
+	p := port(params.Master.Network.Ports, MasterRPCPortName, DefaultMasterRPCPort)
+	return fmt.Sprintf("%s-%s.%s.svc.cluster.local:%d", MasterServiceName, params.Name, params.Namespace, p)
+}
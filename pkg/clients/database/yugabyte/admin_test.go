@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yugabyte
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestTServerAddresses(t *testing.T) {
+	want := []string{
+		"yb-tserver-cool-name-1.yb-tservers-cool-name:9100",
+		"yb-tserver-cool-name-2.yb-tservers-cool-name:9100",
+	}
+	if diff := cmp.Diff(want, TServerAddresses(yugabyteCluster(), 1, 3)); diff != "" {
+		t.Errorf("TServerAddresses(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestChangeBlacklistCommand(t *testing.T) {
+	want := []string{
+		AdminCommand,
+		"--master_addresses", "yb-masters-cool-name.cool-namespace.svc.cluster.local:7100",
+		"change_blacklist", BlacklistAdd,
+		"yb-tserver-cool-name-2.yb-tservers-cool-name:9100",
+	}
+	got := ChangeBlacklistCommand(yugabyteCluster(), BlacklistAdd, []string{"yb-tserver-cool-name-2.yb-tservers-cool-name:9100"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ChangeBlacklistCommand(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestParseLoadMoveCompletion(t *testing.T) {
+	type want struct {
+		percent float64
+		err     error
+	}
+
+	cases := map[string]struct {
+		out  string
+		want want
+	}{
+		"Complete": {
+			out:  "Percent complete = 100\n",
+			want: want{percent: 100},
+		},
+		"InProgress": {
+			out:  "Percent complete = 66.6667 : 2 remaining out of 6\n",
+			want: want{percent: 66.6667},
+		},
+		"Unparseable": {
+			out:  "Error: cool error\n",
+			want: want{err: errors.New(errNoLoadMoveCompletion)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseLoadMoveCompletion(tc.out)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseLoadMoveCompletion(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.percent, got); diff != "" {
+				t.Errorf("ParseLoadMoveCompletion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMasterReplicas(t *testing.T) {
+	cases := map[string]struct {
+		current int32
+		desired int32
+		want    error
+	}{
+		"Unchanged":        {current: 3, desired: 3},
+		"ScaleUp":          {current: 3, desired: 5},
+		"ScaleDown":        {current: 5, desired: 3},
+		"Even":             {current: 3, desired: 2, want: errors.Errorf(errFmtEvenMasters, 3, 2)},
+		"QuorumLost":       {current: 3, desired: 1, want: errors.Errorf(errFmtMasterQuorum, 3, 1)},
+		"UnchangedButEven": {current: 2, desired: 2},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateMasterReplicas(tc.current, tc.desired)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateMasterReplicas(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yugabyte
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/cql"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errNoCluster           = "no YugabyteCluster is referenced"
+	errGetCluster          = "cannot get referenced YugabyteCluster"
+	errNoConnectionSecret  = "referenced YugabyteCluster does not write a connection secret"
+	errGetConnectionSecret = "cannot get connection secret of referenced YugabyteCluster"
+)
+
+// GetYSQLConnection returns the YSQL connection to the referenced
+// YugabyteCluster, as published to its connection secret.
+func GetYSQLConnection(ctx context.Context, c client.Reader, r v1alpha1.YugabyteClusterReference) (pgwire.Connection, error) {
+	cd, err := getConnectionDetails(ctx, c, r)
+	if err != nil {
+		return pgwire.Connection{}, err
+	}
+
+	// The Rook YugabyteDB operator does not configure TLS.
+	return pgwire.ConnectionFrom(cd, pgwire.SSLModeDisable), nil
+}
+
+// GetYCQLConnection returns the YCQL connection to the referenced
+// YugabyteCluster, as published to its connection secret.
+func GetYCQLConnection(ctx context.Context, c client.Reader, r v1alpha1.YugabyteClusterReference) (cql.Connection, error) {
+	cd, err := getConnectionDetails(ctx, c, r)
+	if err != nil {
+		return cql.Connection{}, err
+	}
+
+	// The Rook YugabyteDB operator does not enable YCQL authentication, so
+	// no user is required.
+	return cql.Connection{
+		Host: string(cd[xpv1.ResourceCredentialsSecretEndpointKey]),
+		Port: string(cd[ConnectionSecretYCQLPortKey]),
+	}, nil
+}
+
+// getConnectionDetails returns the connection details the referenced
+// YugabyteCluster publishes to its connection secret.
+func getConnectionDetails(ctx context.Context, c client.Reader, r v1alpha1.YugabyteClusterReference) (managed.ConnectionDetails, error) {
+	if r.Cluster == "" {
+		return nil, errors.New(errNoCluster)
+	}
+
+	yc := &v1alpha1.YugabyteCluster{}
+	if err := c.Get(ctx, types.NamespacedName{Name: r.Cluster}, yc); err != nil {
+		return nil, errors.Wrap(err, errGetCluster)
+	}
+
+	ref := yc.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil, errors.New(errNoConnectionSecret)
+	}
+
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrap(err, errGetConnectionSecret)
+	}
+
+	return s.Data, nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yugabyte
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/cql"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+var errBoom = errors.New("boom")
+
+func connectionSecretData() map[string][]byte {
+	return map[string][]byte{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("yb-tservers-cool-name.cool-namespace.svc"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("5433"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("postgres"),
+		ConnectionSecretYCQLPortKey:               []byte("9042"),
+	}
+}
+
+func TestGetYSQLConnection(t *testing.T) {
+	ref := v1alpha1.YugabyteClusterReference{Cluster: name}
+	noSecret := func(i *v1alpha1.YugabyteCluster) { i.Spec.WriteConnectionSecretToReference = nil }
+
+	type want struct {
+		c   pgwire.Connection
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		ref  v1alpha1.YugabyteClusterReference
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha1.YugabyteCluster:
+						*o = *yugabyteCluster()
+					case *corev1.Secret:
+						o.Data = connectionSecretData()
+					}
+					return nil
+				},
+			},
+			ref: ref,
+			want: want{
+				c: pgwire.Connection{
+					Host:    "yb-tservers-cool-name.cool-namespace.svc",
+					Port:    "5433",
+					User:    "postgres",
+					SSLMode: pgwire.SSLModeDisable,
+				},
+			},
+		},
+		"NoCluster": {
+			ref: v1alpha1.YugabyteClusterReference{},
+			want: want{
+				err: errors.New(errNoCluster),
+			},
+		},
+		"GetClusterError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			ref: ref,
+			want: want{
+				err: errors.Wrap(errBoom, errGetCluster),
+			},
+		},
+		"NoConnectionSecret": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*v1alpha1.YugabyteCluster) = *yugabyteCluster(noSecret)
+					return nil
+				},
+			},
+			ref: ref,
+			want: want{
+				err: errors.New(errNoConnectionSecret),
+			},
+		},
+		"GetConnectionSecretError": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if o, ok := obj.(*v1alpha1.YugabyteCluster); ok {
+						*o = *yugabyteCluster()
+						return nil
+					}
+					return errBoom
+				},
+			},
+			ref: ref,
+			want: want{
+				err: errors.Wrap(errBoom, errGetConnectionSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetYSQLConnection(context.Background(), tc.kube, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetYSQLConnection(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("GetYSQLConnection(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetYCQLConnection(t *testing.T) {
+	ref := v1alpha1.YugabyteClusterReference{Cluster: name}
+
+	type want struct {
+		c   cql.Connection
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		ref  v1alpha1.YugabyteClusterReference
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha1.YugabyteCluster:
+						*o = *yugabyteCluster()
+					case *corev1.Secret:
+						o.Data = connectionSecretData()
+					}
+					return nil
+				},
+			},
+			ref: ref,
+			want: want{
+				c: cql.Connection{
+					Host: "yb-tservers-cool-name.cool-namespace.svc",
+					Port: "9042",
+				},
+			},
+		},
+		"GetClusterError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			ref: ref,
+			want: want{
+				err: errors.Wrap(errBoom, errGetCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetYCQLConnection(context.Background(), tc.kube, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetYCQLConnection(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("GetYCQLConnection(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,15 +17,41 @@ limitations under the License.
 package yugabyte
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
 
 	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
 	rookv1alpha1 "github.com/rook/rook/pkg/apis/yugabytedb.rook.io/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
 )
 
+// Values used by the Rook YugabyteDB operator.
+const (
+	MasterName            = "yb-master"
+	MasterServiceName     = "yb-masters"
+	MasterRPCPortName     = "yb-master-rpc"
+	DefaultMasterRPCPort  = 7100
+	TServerName           = "yb-tserver"
+	TServerServiceName    = "yb-tservers"
+	TServerRPCPortName    = "yb-tserver-rpc"
+	DefaultTServerRPCPort = 9100
+	YSQLPortName          = "ysql"
+	DefaultYSQLPort       = 5433
+	YSQLUser              = "postgres"
+	YCQLPortName          = "ycql"
+	DefaultYCQLPort       = 9042
+)
+
+// ConnectionSecretYCQLPortKey is the key of the YCQL port in the connection
+// secret of a Yugabyte cluster. The standard port key holds the YSQL port.
+const ConnectionSecretYCQLPortKey = "ycqlPort"
+
 // CrossToRook converts a Crossplane Yugabyte cluster object to a Rook Yugabyte
 // cluster object.
 func CrossToRook(c *v1alpha1.YugabyteCluster) *rookv1alpha1.YBCluster {
@@ -44,21 +70,68 @@ func CrossToRook(c *v1alpha1.YugabyteCluster) *rookv1alpha1.YBCluster {
 }
 
 // NeedsUpdate determines whether the external Rook Yugabyte cluster needs to be
-// updated.
+// updated. Volume claim templates are not compared; see
+// KeepVolumeClaimTemplates.
 func NeedsUpdate(c *v1alpha1.YugabyteCluster, e *rookv1alpha1.YBCluster) bool {
-	params := c.Spec.YugabyteClusterParameters
-	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+	want := CrossToRook(c)
+	KeepVolumeClaimTemplates(want, e)
+	if !reflect.DeepEqual(want.Spec.Annotations, e.Spec.Annotations) {
 		return true
 	}
-	if !reflect.DeepEqual(convertServer(params.Master), e.Spec.Master) {
+	if !reflect.DeepEqual(want.Spec.Master, e.Spec.Master) {
 		return true
 	}
-	if !reflect.DeepEqual(convertServer(params.TServer), e.Spec.TServer) {
+	if !reflect.DeepEqual(want.Spec.TServer, e.Spec.TServer) {
 		return true
 	}
 	return false
 }
 
+// KeepVolumeClaimTemplates sets the volume claim templates of the supplied
+// update to those of the supplied existing Rook Yugabyte cluster. The operator
+// copies them to StatefulSets whose volume claim templates cannot be changed,
+// so changing them would cause every subsequent update to fail. Storage
+// increases are instead applied to the existing volume claims.
+func KeepVolumeClaimTemplates(update, e *rookv1alpha1.YBCluster) {
+	update.Spec.Master.VolumeClaimTemplate = e.Spec.Master.VolumeClaimTemplate
+	update.Spec.TServer.VolumeClaimTemplate = e.Spec.TServer.VolumeClaimTemplate
+}
+
+// VolumeClaimNames returns the names of the volume claims that the StatefulSet
+// of the supplied server, either MasterName or TServerName, creates from its
+// volume claim template.
+func VolumeClaimNames(c *v1alpha1.YugabyteCluster, server string, s v1alpha1.ServerSpec) []string {
+	n := c.Spec.YugabyteClusterParameters.Name
+	names := make([]string, s.Replicas)
+	for i := range names {
+		names[i] = fmt.Sprintf("%s-%s-%s-%s-%d", s.VolumeClaimTemplate.GetName(), n, server, n, i)
+	}
+	return names
+}
+
+// ConnectionDetails returns the connection details of the YSQL and YCQL
+// endpoints of the supplied Yugabyte cluster.
+func ConnectionDetails(c *v1alpha1.YugabyteCluster) managed.ConnectionDetails {
+	params := c.Spec.YugabyteClusterParameters
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(fmt.Sprintf("%s-%s.%s.svc", TServerServiceName, params.Name, params.Namespace)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(port(params.TServer.Network.Ports, YSQLPortName, DefaultYSQLPort)))),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(YSQLUser),
+		ConnectionSecretYCQLPortKey:               []byte(strconv.Itoa(int(port(params.TServer.Network.Ports, YCQLPortName, DefaultYCQLPort)))),
+	}
+}
+
+// port returns the port with the supplied name, or the supplied default port
+// if no port has that name.
+func port(ports []v1alpha1.PortSpec, name string, def int32) int32 {
+	for _, p := range ports {
+		if p.Name == name {
+			return p.Port
+		}
+	}
+	return def
+}
+
 func convertServer(server v1alpha1.ServerSpec) rookv1alpha1.ServerSpec {
 	return rookv1alpha1.ServerSpec{
 		Replicas: server.Replicas,
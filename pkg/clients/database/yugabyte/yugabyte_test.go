@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
 )
@@ -91,6 +92,10 @@ func withMasterReplicas(i int32) rookYugabyteClusterModifier {
 	return func(c *rookv1alpha1.YBCluster) { c.Spec.Master.Replicas = i }
 }
 
+func withMasterVolumeClaimTemplate(n string) rookYugabyteClusterModifier {
+	return func(c *rookv1alpha1.YBCluster) { c.Spec.Master.VolumeClaimTemplate.SetName(n) }
+}
+
 func rookYugabyteCluster(im ...rookYugabyteClusterModifier) *rookv1alpha1.YBCluster {
 	i := &rookv1alpha1.YBCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -219,6 +224,11 @@ func TestNeedsUpdate(t *testing.T) {
 			r:    rookYugabyteCluster(withMasterReplicas(mr)),
 			want: true,
 		},
+		"VolumeClaimTemplateIgnored": {
+			c:    yugabyteCluster(),
+			r:    rookYugabyteCluster(withMasterVolumeClaimTemplate("cool-data")),
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -231,6 +241,20 @@ func TestNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestVolumeClaimNames(t *testing.T) {
+	c := yugabyteCluster()
+	c.Spec.YugabyteClusterParameters.TServer.VolumeClaimTemplate.SetName("datadir")
+	want := []string{
+		"datadir-cool-name-yb-tserver-cool-name-0",
+		"datadir-cool-name-yb-tserver-cool-name-1",
+		"datadir-cool-name-yb-tserver-cool-name-2",
+	}
+	got := VolumeClaimNames(c, TServerName, c.Spec.YugabyteClusterParameters.TServer)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("VolumeClaimNames(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestConvertServer(t *testing.T) {
 	r := int32(5)
 
@@ -257,3 +281,45 @@ func TestConvertServer(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionDetails(t *testing.T) {
+	ports := func(i *v1alpha1.YugabyteCluster) {
+		i.Spec.YugabyteClusterParameters.TServer.Network.Ports = []v1alpha1.PortSpec{
+			{Name: YSQLPortName, Port: 5434},
+			{Name: YCQLPortName, Port: 9043},
+		}
+	}
+
+	cases := map[string]struct {
+		c    *v1alpha1.YugabyteCluster
+		want managed.ConnectionDetails
+	}{
+		"DefaultPorts": {
+			c: yugabyteCluster(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("yb-tservers-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("5433"),
+				xpv1.ResourceCredentialsSecretUserKey:     []byte("postgres"),
+				ConnectionSecretYCQLPortKey:               []byte("9042"),
+			},
+		},
+		"Ports": {
+			c: yugabyteCluster(ports),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("yb-tservers-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("5434"),
+				xpv1.ResourceCredentialsSecretUserKey:     []byte("postgres"),
+				ConnectionSecretYCQLPortKey:               []byte("9043"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
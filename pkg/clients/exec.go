@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	errNewClientset = "cannot create a new Kubernetes clientset"
+	errNewExecutor  = "cannot create a new command executor"
+	errFmtExec      = "cannot run command in pod %s/%s: %s"
+)
+
+// An Executor runs commands in pods.
+type Executor interface {
+	// Exec runs the supplied command in the first container of the supplied
+	// pod, and returns what it wrote to stdout.
+	Exec(ctx context.Context, namespace, pod string, command ...string) (string, error)
+}
+
+// NewExecutor returns an Executor that runs commands in the pods of the
+// Kubernetes cluster described by the supplied rest config.
+func NewExecutor(cfg *rest.Config) (Executor, error) {
+	cs, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClientset)
+	}
+	return &podExecutor{config: cfg, client: cs.CoreV1().RESTClient()}, nil
+}
+
+type podExecutor struct {
+	config *rest.Config
+	client rest.Interface
+}
+
+// Exec runs the supplied command. The remote command client does not accept a
+// context, so the command is not cancelled when the context is.
+func (p *podExecutor) Exec(_ context.Context, namespace, pod string, command ...string) (string, error) {
+	req := p.client.Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Command: command,
+			Stdout:  true,
+			Stderr:  true,
+		}, scheme.ParameterCodec)
+
+	ex, err := remotecommand.NewSPDYExecutor(p.config, "POST", req.URL())
+	if err != nil {
+		return "", errors.Wrap(err, errNewExecutor)
+	}
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := ex.Stream(remotecommand.StreamOptions{Stdout: stdout, Stderr: stderr}); err != nil {
+		return "", errors.Wrapf(err, errFmtExec, namespace, pod, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
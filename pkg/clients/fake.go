@@ -0,0 +1,29 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import "context"
+
+// A MockExecutor is an Executor whose Exec method is supplied as a function.
+type MockExecutor struct {
+	MockExec func(ctx context.Context, namespace, pod string, command ...string) (string, error)
+}
+
+// Exec calls MockExec.
+func (m *MockExecutor) Exec(ctx context.Context, namespace, pod string, command ...string) (string, error) {
+	return m.MockExec(ctx, namespace, pod, command...)
+}
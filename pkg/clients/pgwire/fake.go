@@ -0,0 +1,47 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pgwire
+
+import "context"
+
+// A MockDB is a DB whose methods are supplied as functions.
+type MockDB struct {
+	MockExec    func(ctx context.Context, query string, args ...interface{}) error
+	MockExists  func(ctx context.Context, query string, args ...interface{}) (bool, error)
+	MockStrings func(ctx context.Context, query string, args ...interface{}) ([]string, error)
+	MockScan    func(ctx context.Context, query string, dest []interface{}, args ...interface{}) error
+}
+
+// Exec calls MockExec.
+func (m *MockDB) Exec(ctx context.Context, query string, args ...interface{}) error {
+	return m.MockExec(ctx, query, args...)
+}
+
+// Exists calls MockExists.
+func (m *MockDB) Exists(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	return m.MockExists(ctx, query, args...)
+}
+
+// Strings calls MockStrings.
+func (m *MockDB) Strings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	return m.MockStrings(ctx, query, args...)
+}
+
+// Scan calls MockScan.
+func (m *MockDB) Scan(ctx context.Context, query string, dest []interface{}, args ...interface{}) error {
+	return m.MockScan(ctx, query, dest, args...)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pgwire contains a client for databases that speak the Postgres wire
+// protocol, such as CockroachDB and the YSQL API of YugabyteDB.
+package pgwire
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"net/url"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+// SSL modes understood by the Postgres driver.
+const (
+	SSLModeDisable = "disable"
+	SSLModeRequire = "require"
+)
+
+// Error codes returned when a database or a relation does not exist.
+const (
+	errCodeInvalidCatalogName pq.ErrorCode = "3D000"
+	errCodeUndefinedTable     pq.ErrorCode = "42P01"
+)
+
+// A DB runs statements against a database that speaks the Postgres wire
+// protocol.
+type DB interface {
+	// Exec runs the supplied statement.
+	Exec(ctx context.Context, query string, args ...interface{}) error
+
+	// Exists returns true if the supplied query returns any rows.
+	Exists(ctx context.Context, query string, args ...interface{}) (bool, error)
+
+	// Strings returns the first column of each row returned by the supplied
+	// query, which must be a string.
+	Strings(ctx context.Context, query string, args ...interface{}) ([]string, error)
+
+	// Scan copies the columns of the single row returned by the supplied
+	// query into the supplied destinations. It returns an error for which
+	// IsNoRows returns true if the query returns no rows.
+	Scan(ctx context.Context, query string, dest []interface{}, args ...interface{}) error
+}
+
+// IsNoRows returns true if the supplied error indicates that a query returned
+// no rows.
+func IsNoRows(err error) bool {
+	return err == sql.ErrNoRows
+}
+
+// IsUnreachable returns true if the supplied error indicates that the database
+// could not be reached, for example because it does not yet accept
+// connections.
+func IsUnreachable(err error) bool {
+	var op *net.OpError
+	var dns *net.DNSError
+	return errors.As(err, &op) || errors.As(err, &dns)
+}
+
+// IsUndefined returns true if the supplied error indicates that a database or
+// a relation does not exist.
+func IsUndefined(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == errCodeInvalidCatalogName || pqErr.Code == errCodeUndefinedTable
+}
+
+// A Connection describes how to connect to a database.
+type Connection struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	SSLMode  string
+}
+
+// ConnectionFrom returns the connection described by the supplied connection
+// details, as published by a database cluster.
+func ConnectionFrom(cd managed.ConnectionDetails, sslMode string) Connection {
+	return Connection{
+		Host:     string(cd[xpv1.ResourceCredentialsSecretEndpointKey]),
+		Port:     string(cd[xpv1.ResourceCredentialsSecretPortKey]),
+		User:     string(cd[xpv1.ResourceCredentialsSecretUserKey]),
+		Password: string(cd[xpv1.ResourceCredentialsSecretPasswordKey]),
+		SSLMode:  sslMode,
+	}
+}
+
+// UserConnectionDetails returns the connection details of the supplied user of
+// the supplied connection.
+func UserConnectionDetails(c Connection, user string) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(c.Host),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(c.Port),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+	}
+}
+
+// DSN returns the data source name of the supplied connection.
+func DSN(c Connection) string {
+	u := &url.URL{
+		Scheme:   "postgres",
+		User:     url.User(c.User),
+		Host:     net.JoinHostPort(c.Host, c.Port),
+		Path:     "/",
+		RawQuery: url.Values{"sslmode": []string{c.SSLMode}}.Encode(),
+	}
+	if c.Password != "" {
+		u.User = url.UserPassword(c.User, c.Password)
+	}
+	return u.String()
+}
+
+// QuoteIdentifier quotes the supplied identifier for use in a statement.
+func QuoteIdentifier(id string) string {
+	return pq.QuoteIdentifier(id)
+}
+
+// QuoteLiteral quotes the supplied string literal for use in a statement.
+func QuoteLiteral(l string) string {
+	return pq.QuoteLiteral(l)
+}
+
+// A postgresDB is a DB that connects using the Postgres driver. A connection
+// is opened and closed for each call, because managed resource controllers
+// connect to a different database for each reconcile.
+type postgresDB struct {
+	dsn string
+}
+
+// New returns a DB that connects to the supplied data source.
+func New(dsn string) DB {
+	return &postgresDB{dsn: dsn}
+}
+
+func (p *postgresDB) Exec(ctx context.Context, query string, args ...interface{}) error {
+	db, err := sql.Open("postgres", p.dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (p *postgresDB) Exists(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	exists := false
+	err := p.query(ctx, func(r *sql.Rows) error {
+		exists = true
+		return nil
+	}, query, args...)
+	return exists, err
+}
+
+func (p *postgresDB) Strings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	out := []string{}
+	err := p.query(ctx, func(r *sql.Rows) error {
+		var s string
+		if err := r.Scan(&s); err != nil {
+			return err
+		}
+		out = append(out, s)
+		return nil
+	}, query, args...)
+	return out, err
+}
+
+func (p *postgresDB) Scan(ctx context.Context, query string, dest []interface{}, args ...interface{}) error {
+	db, err := sql.Open("postgres", p.dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.QueryRowContext(ctx, query, args...).Scan(dest...)
+}
+
+// query runs the supplied query and calls fn for each row it returns.
+func (p *postgresDB) query(ctx context.Context, fn func(*sql.Rows) error, query string, args ...interface{}) error {
+	db, err := sql.Open("postgres", p.dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
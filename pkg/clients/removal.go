@@ -0,0 +1,45 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Some external resources, such as Cockroach cluster settings and zone
+// configurations, always exist. Deleting them resets them rather than removing
+// them, so they cannot be observed not to exist. The managed reconciler only
+// removes the finalizer of a deleted managed resource once Observe reports that
+// its external resource does not exist, so the reset must be recorded on the
+// managed resource itself. Its Deleting condition is used, because status is
+// persisted after Delete and is the only state that survives until the next
+// Observe.
+
+// SetRemoved records that the supplied managed resource's external resource,
+// which always exists, has been reset by a delete.
+func SetRemoved(mg resource.Managed) {
+	mg.SetConditions(xpv1.Deleting())
+}
+
+// IsRemoved returns true if the supplied managed resource was deleted and its
+// external resource, which always exists, has been reset since. Observe should
+// report such an external resource as not existing.
+func IsRemoved(mg resource.Managed) bool {
+	return meta.WasDeleted(mg) && mg.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonDeleting
+}
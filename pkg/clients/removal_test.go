@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestIsRemoved(t *testing.T) {
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		deleted bool
+		removed bool
+		want    bool
+	}{
+		"NotDeleted": {
+			want: false,
+		},
+		"DeletedButNotRemoved": {
+			deleted: true,
+			want:    false,
+		},
+		"DeletedAndRemoved": {
+			deleted: true,
+			removed: true,
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(xpv1.Available())
+			if tc.deleted {
+				mg.SetDeletionTimestamp(&now)
+			}
+			if tc.removed {
+				SetRemoved(mg)
+			}
+			if diff := cmp.Diff(tc.want, IsRemoved(mg)); diff != "" {
+				t.Errorf("IsRemoved(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// Error strings.
+const (
+	errNoSource        = "one of blockPool or filesystem must be set"
+	errMultipleSources = "only one of blockPool or filesystem may be set"
+)
+
+// SecretName is the name of the Secret in the Rook Ceph cluster namespace
+// that holds the credentials the Rook CSI drivers use.
+const SecretName = "rook-ceph-csi"
+
+// Parameter keys understood by the CSI external provisioner and snapshotter.
+const (
+	ParamClusterID                  = "clusterID"
+	ParamPool                       = "pool"
+	ParamFSName                     = "fsName"
+	ParamImageFormat                = "imageFormat"
+	ParamImageFeatures              = "imageFeatures"
+	ParamFSType                     = "csi.storage.k8s.io/fstype"
+	ParamProvisionerSecretName      = "csi.storage.k8s.io/provisioner-secret-name"
+	ParamProvisionerSecretNamespace = "csi.storage.k8s.io/provisioner-secret-namespace"
+	ParamNodeStageSecretName        = "csi.storage.k8s.io/node-stage-secret-name"
+	ParamNodeStageSecretNamespace   = "csi.storage.k8s.io/node-stage-secret-namespace"
+	ParamSnapshotterSecretName      = "csi.storage.k8s.io/snapshotter-secret-name"
+	ParamSnapshotterSecretNamespace = "csi.storage.k8s.io/snapshotter-secret-namespace"
+)
+
+// ValidateSource returns an error unless exactly one of the block pool and
+// filesystem of the supplied source is set.
+func ValidateSource(s v1alpha1.CephCSISource) error {
+	switch {
+	case s.BlockPool == nil && s.Filesystem == nil:
+		return errors.New(errNoSource)
+	case s.BlockPool != nil && s.Filesystem != nil:
+		return errors.New(errMultipleSources)
+	}
+	return nil
+}
+
+// RBDDriverName returns the name of the RBD CSI driver deployed by the Rook
+// operator running in the supplied namespace.
+func RBDDriverName(operatorNamespace string) string {
+	return fmt.Sprintf("%s.rbd.csi.ceph.com", operatorNamespace)
+}
+
+// CephFSDriverName returns the name of the CephFS CSI driver deployed by the
+// Rook operator running in the supplied namespace.
+func CephFSDriverName(operatorNamespace string) string {
+	return fmt.Sprintf("%s.cephfs.csi.ceph.com", operatorNamespace)
+}
+
+// IsCephClusterReady determines whether any of the supplied Rook Ceph
+// clusters has been created.
+func IsCephClusterReady(l *cephv1.CephClusterList) bool {
+	for _, c := range l.Items {
+		if c.Status.State == cephv1.ClusterStateCreated {
+			return true
+		}
+	}
+	return false
+}
+
+func operatorNamespace(clusterNamespace, operatorNamespace string) string {
+	if operatorNamespace == "" {
+		return clusterNamespace
+	}
+	return operatorNamespace
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+
+	providerName = "cool-rook"
+
+	blockPool  = "cool-pool"
+	filesystem = "cool-fs"
+)
+
+func TestValidateSource(t *testing.T) {
+	pool, fs := blockPool, filesystem
+
+	cases := map[string]struct {
+		s    v1alpha1.CephCSISource
+		want error
+	}{
+		"BlockPool": {
+			s: v1alpha1.CephCSISource{ClusterNamespace: namespace, BlockPool: &pool},
+		},
+		"Filesystem": {
+			s: v1alpha1.CephCSISource{ClusterNamespace: namespace, Filesystem: &fs},
+		},
+		"NoSource": {
+			s:    v1alpha1.CephCSISource{ClusterNamespace: namespace},
+			want: errors.New(errNoSource),
+		},
+		"MultipleSources": {
+			s:    v1alpha1.CephCSISource{ClusterNamespace: namespace, BlockPool: &pool, Filesystem: &fs},
+			want: errors.New(errMultipleSources),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateSource(tc.s)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateSource(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCephClusterReady(t *testing.T) {
+	cases := map[string]struct {
+		l    *cephv1.CephClusterList
+		want bool
+	}{
+		"NoClusters": {
+			l:    &cephv1.CephClusterList{},
+			want: false,
+		},
+		"ClusterCreating": {
+			l: &cephv1.CephClusterList{Items: []cephv1.CephCluster{
+				{Status: cephv1.ClusterStatus{State: cephv1.ClusterStateCreating}},
+			}},
+			want: false,
+		},
+		"ClusterCreated": {
+			l: &cephv1.CephClusterList{Items: []cephv1.CephCluster{
+				{Status: cephv1.ClusterStatus{State: cephv1.ClusterStateCreated}},
+			}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCephClusterReady(tc.l)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsCephClusterReady(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
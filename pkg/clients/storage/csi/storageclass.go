@@ -0,0 +1,135 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// Defaults of optional CephStorageClass parameters.
+const (
+	DefaultImageFormat   = "2"
+	DefaultImageFeatures = "layering"
+)
+
+// StorageClassCrossToK8s converts a Crossplane Ceph storage class object to a
+// Kubernetes storage class object.
+func StorageClassCrossToK8s(c *v1alpha1.CephStorageClass) *storagev1.StorageClass {
+	params := c.Spec.CephStorageClassParameters
+	return &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: params.Name,
+		},
+		Provisioner:          storageClassProvisioner(params),
+		Parameters:           storageClassParameters(params),
+		ReclaimPolicy:        reclaimPolicy(params.ReclaimPolicy),
+		AllowVolumeExpansion: params.AllowVolumeExpansion,
+		MountOptions:         params.MountOptions,
+		VolumeBindingMode:    volumeBindingMode(params.VolumeBindingMode),
+	}
+}
+
+// StorageClassNeedsUpdate determines whether the external Kubernetes storage
+// class needs to be updated. The parameters of a storage class are immutable,
+// so a storage class that needs to be updated must be replaced.
+func StorageClassNeedsUpdate(c *v1alpha1.CephStorageClass, e *storagev1.StorageClass) bool {
+	want := StorageClassCrossToK8s(c)
+	if want.Provisioner != e.Provisioner {
+		return true
+	}
+	if !reflect.DeepEqual(want.Parameters, e.Parameters) {
+		return true
+	}
+	if !reflect.DeepEqual(want.ReclaimPolicy, e.ReclaimPolicy) {
+		return true
+	}
+	if !reflect.DeepEqual(want.AllowVolumeExpansion, e.AllowVolumeExpansion) {
+		return true
+	}
+	if !reflect.DeepEqual(want.MountOptions, e.MountOptions) {
+		return true
+	}
+	if !reflect.DeepEqual(want.VolumeBindingMode, e.VolumeBindingMode) {
+		return true
+	}
+	return false
+}
+
+func storageClassProvisioner(p v1alpha1.CephStorageClassParameters) string {
+	ns := operatorNamespace(p.ClusterNamespace, p.OperatorNamespace)
+	if p.Filesystem != nil {
+		return CephFSDriverName(ns)
+	}
+	return RBDDriverName(ns)
+}
+
+func storageClassParameters(p v1alpha1.CephStorageClassParameters) map[string]string {
+	params := map[string]string{
+		ParamClusterID:                  p.ClusterNamespace,
+		ParamProvisionerSecretName:      SecretName,
+		ParamProvisionerSecretNamespace: p.ClusterNamespace,
+		ParamNodeStageSecretName:        SecretName,
+		ParamNodeStageSecretNamespace:   p.ClusterNamespace,
+	}
+
+	if p.Filesystem != nil {
+		params[ParamFSName] = *p.Filesystem
+		params[ParamPool] = p.DataPool
+		if p.DataPool == "" {
+			params[ParamPool] = fmt.Sprintf("%s-data0", *p.Filesystem)
+		}
+		return params
+	}
+
+	if p.BlockPool != nil {
+		params[ParamPool] = *p.BlockPool
+	}
+	params[ParamImageFormat] = DefaultImageFormat
+	params[ParamImageFeatures] = p.ImageFeatures
+	if p.ImageFeatures == "" {
+		params[ParamImageFeatures] = DefaultImageFeatures
+	}
+	if p.FSType != "" {
+		params[ParamFSType] = p.FSType
+	}
+	return params
+}
+
+// The API server defaults the reclaim policy and volume binding mode of a
+// storage class, so we do too in order to compare them.
+func reclaimPolicy(p *corev1.PersistentVolumeReclaimPolicy) *corev1.PersistentVolumeReclaimPolicy {
+	if p != nil {
+		return p
+	}
+	d := corev1.PersistentVolumeReclaimDelete
+	return &d
+}
+
+func volumeBindingMode(m *storagev1.VolumeBindingMode) *storagev1.VolumeBindingMode {
+	if m != nil {
+		return m
+	}
+	d := storagev1.VolumeBindingImmediate
+	return &d
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+type cephStorageClassModifier func(*v1alpha1.CephStorageClass)
+
+func withFilesystem(fs string) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) {
+		i.Spec.CephStorageClassParameters.BlockPool = nil
+		i.Spec.CephStorageClassParameters.Filesystem = &fs
+	}
+}
+
+func withFSType(t string) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.Spec.CephStorageClassParameters.FSType = t }
+}
+
+func withOperatorNamespace(ns string) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.Spec.CephStorageClassParameters.OperatorNamespace = ns }
+}
+
+func cephStorageClass(im ...cephStorageClassModifier) *v1alpha1.CephStorageClass {
+	pool := blockPool
+	i := &v1alpha1.CephStorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CephStorageClassSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference: &xpv1.Reference{Name: providerName},
+			},
+			CephStorageClassParameters: v1alpha1.CephStorageClassParameters{
+				Name: name,
+				CephCSISource: v1alpha1.CephCSISource{
+					ClusterNamespace: namespace,
+					BlockPool:        &pool,
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type storageClassModifier func(*storagev1.StorageClass)
+
+func withProvisioner(p string) storageClassModifier {
+	return func(i *storagev1.StorageClass) { i.Provisioner = p }
+}
+
+func withParameter(k, v string) storageClassModifier {
+	return func(i *storagev1.StorageClass) { i.Parameters[k] = v }
+}
+
+func withoutParameter(k string) storageClassModifier {
+	return func(i *storagev1.StorageClass) { delete(i.Parameters, k) }
+}
+
+func storageClass(im ...storageClassModifier) *storagev1.StorageClass {
+	reclaim := corev1.PersistentVolumeReclaimDelete
+	binding := storagev1.VolumeBindingImmediate
+	i := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Provisioner: "cool-namespace.rbd.csi.ceph.com",
+		Parameters: map[string]string{
+			ParamClusterID:                  namespace,
+			ParamPool:                       blockPool,
+			ParamImageFormat:                "2",
+			ParamImageFeatures:              "layering",
+			ParamProvisionerSecretName:      SecretName,
+			ParamProvisionerSecretNamespace: namespace,
+			ParamNodeStageSecretName:        SecretName,
+			ParamNodeStageSecretNamespace:   namespace,
+		},
+		ReclaimPolicy:     &reclaim,
+		VolumeBindingMode: &binding,
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestStorageClassCrossToK8s(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CephStorageClass
+		want *storagev1.StorageClass
+	}{
+		"BlockPool": {
+			c:    cephStorageClass(),
+			want: storageClass(),
+		},
+		"BlockPoolWithFSType": {
+			c:    cephStorageClass(withFSType("xfs")),
+			want: storageClass(withParameter(ParamFSType, "xfs")),
+		},
+		"BlockPoolWithOperatorNamespace": {
+			c:    cephStorageClass(withOperatorNamespace("rook-ceph-system")),
+			want: storageClass(withProvisioner("rook-ceph-system.rbd.csi.ceph.com")),
+		},
+		"Filesystem": {
+			c: cephStorageClass(withFilesystem(filesystem)),
+			want: storageClass(
+				withProvisioner("cool-namespace.cephfs.csi.ceph.com"),
+				withParameter(ParamFSName, filesystem),
+				withParameter(ParamPool, "cool-fs-data0"),
+				withoutParameter(ParamImageFormat),
+				withoutParameter(ParamImageFeatures),
+			),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := StorageClassCrossToK8s(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("StorageClassCrossToK8s(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStorageClassNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CephStorageClass
+		e    *storagev1.StorageClass
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    cephStorageClass(),
+			e:    storageClass(),
+			want: false,
+		},
+		"ParametersChanged": {
+			c:    cephStorageClass(withFSType("xfs")),
+			e:    storageClass(),
+			want: true,
+		},
+		"ProvisionerChanged": {
+			c:    cephStorageClass(),
+			e:    storageClass(withProvisioner("rook-ceph-system.rbd.csi.ceph.com")),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := StorageClassNeedsUpdate(tc.c, tc.e)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("StorageClassNeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// VolumeSnapshotClassGroupVersionKind is the kind of volume snapshot class
+// understood by the snapshotter sidecar deployed by Rook v1.1.
+var VolumeSnapshotClassGroupVersionKind = schema.GroupVersionKind{
+	Group:   "snapshot.storage.k8s.io",
+	Version: "v1alpha1",
+	Kind:    "VolumeSnapshotClass",
+}
+
+// NewVolumeSnapshotClass returns an empty Kubernetes volume snapshot class
+// object.
+func NewVolumeSnapshotClass() *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(VolumeSnapshotClassGroupVersionKind)
+	return u
+}
+
+// VolumeSnapshotClassCrossToK8s converts a Crossplane Ceph volume snapshot
+// class object to a Kubernetes volume snapshot class object.
+func VolumeSnapshotClassCrossToK8s(c *v1alpha1.CephVolumeSnapshotClass) *unstructured.Unstructured {
+	params := c.Spec.CephVolumeSnapshotClassParameters
+	u := NewVolumeSnapshotClass()
+	u.SetName(params.Name)
+	u.Object["snapshotter"] = RBDDriverName(operatorNamespace(params.ClusterNamespace, params.OperatorNamespace))
+	u.Object["parameters"] = map[string]interface{}{
+		ParamClusterID:                  params.ClusterNamespace,
+		ParamPool:                       params.BlockPool,
+		ParamSnapshotterSecretName:      SecretName,
+		ParamSnapshotterSecretNamespace: params.ClusterNamespace,
+	}
+	return u
+}
+
+// VolumeSnapshotClassNeedsUpdate determines whether the external Kubernetes
+// volume snapshot class needs to be updated. The parameters of a volume
+// snapshot class are immutable, so a volume snapshot class that needs to be
+// updated must be replaced.
+func VolumeSnapshotClassNeedsUpdate(c *v1alpha1.CephVolumeSnapshotClass, e *unstructured.Unstructured) bool {
+	want := VolumeSnapshotClassCrossToK8s(c)
+	if !reflect.DeepEqual(want.Object["snapshotter"], e.Object["snapshotter"]) {
+		return true
+	}
+	wp, _, _ := unstructured.NestedStringMap(want.Object, "parameters")
+	ep, _, _ := unstructured.NestedStringMap(e.Object, "parameters")
+	return !reflect.DeepEqual(wp, ep)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+type cephVolumeSnapshotClassModifier func(*v1alpha1.CephVolumeSnapshotClass)
+
+func withSnapshotBlockPool(p string) cephVolumeSnapshotClassModifier {
+	return func(i *v1alpha1.CephVolumeSnapshotClass) { i.Spec.CephVolumeSnapshotClassParameters.BlockPool = p }
+}
+
+func cephVolumeSnapshotClass(im ...cephVolumeSnapshotClassModifier) *v1alpha1.CephVolumeSnapshotClass {
+	i := &v1alpha1.CephVolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CephVolumeSnapshotClassSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference: &xpv1.Reference{Name: providerName},
+			},
+			CephVolumeSnapshotClassParameters: v1alpha1.CephVolumeSnapshotClassParameters{
+				Name:             name,
+				ClusterNamespace: namespace,
+				BlockPool:        blockPool,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func volumeSnapshotClass() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1alpha1",
+		"kind":       "VolumeSnapshotClass",
+		"metadata": map[string]interface{}{
+			"name": name,
+		},
+		"snapshotter": "cool-namespace.rbd.csi.ceph.com",
+		"parameters": map[string]interface{}{
+			ParamClusterID:                  namespace,
+			ParamPool:                       blockPool,
+			ParamSnapshotterSecretName:      SecretName,
+			ParamSnapshotterSecretNamespace: namespace,
+		},
+	}}
+}
+
+func TestVolumeSnapshotClassCrossToK8s(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CephVolumeSnapshotClass
+		want *unstructured.Unstructured
+	}{
+		"Successful": {
+			c:    cephVolumeSnapshotClass(),
+			want: volumeSnapshotClass(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := VolumeSnapshotClassCrossToK8s(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("VolumeSnapshotClassCrossToK8s(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVolumeSnapshotClassNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.CephVolumeSnapshotClass
+		e    *unstructured.Unstructured
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    cephVolumeSnapshotClass(),
+			e:    volumeSnapshotClass(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    cephVolumeSnapshotClass(withSnapshotBlockPool("other-pool")),
+			e:    volumeSnapshotClass(),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := VolumeSnapshotClassNeedsUpdate(tc.c, tc.e)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("VolumeSnapshotClassNeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
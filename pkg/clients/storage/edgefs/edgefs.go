@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"reflect"
+
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+// ClusterCrossToRook converts a Crossplane EdgeFS cluster object to a Rook
+// EdgeFS cluster object.
+func ClusterCrossToRook(c *v1alpha1.EdgefsCluster) *rookv1.Cluster {
+	params := c.Spec.EdgefsClusterParameters
+	return &rookv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1.ClusterSpec{
+			Storage:              convertStorage(params.Storage),
+			Annotations:          convertAnnotations(params.Annotations),
+			Placement:            convertPlacementSpec(params.Placement),
+			Network:              convertNetwork(params.Network),
+			Resources:            params.Resources,
+			DataDirHostPath:      params.DataDirHostPath,
+			ServiceAccount:       params.ServiceAccount,
+			DevicesResurrectMode: params.DevicesResurrectMode,
+			EdgefsImageName:      params.EdgefsImageName,
+			SkipHostPrepare:      params.SkipHostPrepare,
+			ResourceProfile:      params.ResourceProfile,
+			UseHostLocalTime:     params.UseHostLocalTime,
+		},
+	}
+}
+
+// ClusterNeedsUpdate determines whether the external Rook EdgeFS cluster
+// needs to be updated.
+func ClusterNeedsUpdate(c *v1alpha1.EdgefsCluster, e *rookv1.Cluster) bool {
+	params := c.Spec.EdgefsClusterParameters
+	if !reflect.DeepEqual(convertStorage(params.Storage), e.Spec.Storage) {
+		return true
+	}
+	if !reflect.DeepEqual(convertAnnotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if !reflect.DeepEqual(convertPlacementSpec(params.Placement), e.Spec.Placement) {
+		return true
+	}
+	if !reflect.DeepEqual(convertNetwork(params.Network), e.Spec.Network) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Resources, e.Spec.Resources) {
+		return true
+	}
+	if params.DataDirHostPath != e.Spec.DataDirHostPath {
+		return true
+	}
+	if params.ServiceAccount != e.Spec.ServiceAccount {
+		return true
+	}
+	if params.DevicesResurrectMode != e.Spec.DevicesResurrectMode {
+		return true
+	}
+	if params.EdgefsImageName != e.Spec.EdgefsImageName {
+		return true
+	}
+	if params.SkipHostPrepare != e.Spec.SkipHostPrepare {
+		return true
+	}
+	if params.ResourceProfile != e.Spec.ResourceProfile {
+		return true
+	}
+	if params.UseHostLocalTime != e.Spec.UseHostLocalTime {
+		return true
+	}
+	return false
+}
+
+// IsServiceAvailable determines whether all instances of the Deployment that
+// backs an EdgeFS service are ready.
+func IsServiceAvailable(d *appsv1.Deployment, instances int32) bool {
+	return d.Status.ReadyReplicas >= instances
+}
+
+func convertStorage(s v1alpha1.EdgefsStorageSpec) rook.StorageScopeSpec {
+	var dirs []rook.Directory
+	if len(s.Directories) > 0 {
+		dirs = make([]rook.Directory, len(s.Directories))
+		for i, d := range s.Directories {
+			dirs[i] = rook.Directory{Path: d}
+		}
+	}
+	return rook.StorageScopeSpec{
+		UseAllNodes: s.UseAllNodes,
+		Config:      s.Config,
+		Selection: rook.Selection{
+			UseAllDevices: s.UseAllDevices,
+			DeviceFilter:  s.DeviceFilter,
+			Directories:   dirs,
+		},
+	}
+}
+
+func convertAnnotations(a corev1alpha1.Annotations) rook.AnnotationsSpec {
+	if a == nil {
+		return nil
+	}
+	return rook.AnnotationsSpec{rook.KeyAll: rook.Annotations(a)}
+}
+
+func convertPlacementSpec(p *corev1alpha1.Placement) rook.PlacementSpec {
+	if p == nil {
+		return nil
+	}
+	return rook.PlacementSpec{rook.KeyAll: convertPlacement(p)}
+}
+
+func convertPlacement(p *corev1alpha1.Placement) rook.Placement {
+	if p == nil {
+		return rook.Placement{}
+	}
+	return rook.Placement{
+		NodeAffinity:    p.NodeAffinity,
+		PodAffinity:     p.PodAffinity,
+		PodAntiAffinity: p.PodAntiAffinity,
+		Tolerations:     p.Tolerations,
+	}
+}
+
+func convertNetwork(n *v1alpha1.NetworkSpec) rook.NetworkSpec {
+	if n == nil {
+		return rook.NetworkSpec{}
+	}
+	return rook.NetworkSpec{
+		Provider:  n.Provider,
+		Selectors: n.Selectors,
+	}
+}
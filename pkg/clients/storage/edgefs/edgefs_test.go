@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+
+	providerName         = "cool-rook"
+	connectionSecretName = "cool-connection-secret"
+)
+
+var tolerations = []corev1.Toleration{{Key: "cool-key", Operator: corev1.TolerationOpExists}}
+
+type edgefsClusterModifier func(*v1alpha1.EdgefsCluster)
+
+func withEdgefsDirectories(d ...string) edgefsClusterModifier {
+	return func(i *v1alpha1.EdgefsCluster) { i.Spec.EdgefsClusterParameters.Storage.Directories = d }
+}
+
+func withEdgefsPlacement(p *corev1alpha1.Placement) edgefsClusterModifier {
+	return func(i *v1alpha1.EdgefsCluster) { i.Spec.EdgefsClusterParameters.Placement = p }
+}
+
+func edgefsCluster(im ...edgefsClusterModifier) *v1alpha1.EdgefsCluster {
+	i := &v1alpha1.EdgefsCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.EdgefsClusterSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			EdgefsClusterParameters: v1alpha1.EdgefsClusterParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Storage: v1alpha1.EdgefsStorageSpec{
+					UseAllNodes: true,
+					Config:      map[string]string{"useMetadataOffload": "false"},
+				},
+				DataDirHostPath: "/var/lib/edgefs",
+				ServiceAccount:  "rook-edgefs-cluster",
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookEdgefsClusterModifier func(*rookv1.Cluster)
+
+func withDirectories(d ...rook.Directory) rookEdgefsClusterModifier {
+	return func(i *rookv1.Cluster) { i.Spec.Storage.Directories = d }
+}
+
+func withPlacement(p rook.PlacementSpec) rookEdgefsClusterModifier {
+	return func(i *rookv1.Cluster) { i.Spec.Placement = p }
+}
+
+func withState(s rookv1.ClusterState) rookEdgefsClusterModifier {
+	return func(i *rookv1.Cluster) { i.Status.State = s }
+}
+
+func rookEdgefsCluster(im ...rookEdgefsClusterModifier) *rookv1.Cluster {
+	i := &rookv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1.ClusterSpec{
+			Annotations: rook.AnnotationsSpec{rook.KeyAll: rook.Annotations(map[string]string{"label": "value"})},
+			Storage: rook.StorageScopeSpec{
+				UseAllNodes: true,
+				Config:      map[string]string{"useMetadataOffload": "false"},
+			},
+			DataDirHostPath: "/var/lib/edgefs",
+			ServiceAccount:  "rook-edgefs-cluster",
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestClusterCrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsCluster
+		want *rookv1.Cluster
+	}{
+		"Successful": {
+			c:    edgefsCluster(),
+			want: rookEdgefsCluster(),
+		},
+		"SuccessfulWithDirectories": {
+			c:    edgefsCluster(withEdgefsDirectories("/data0", "/data1")),
+			want: rookEdgefsCluster(withDirectories(rook.Directory{Path: "/data0"}, rook.Directory{Path: "/data1"})),
+		},
+		"SuccessfulWithPlacement": {
+			c:    edgefsCluster(withEdgefsPlacement(&corev1alpha1.Placement{Tolerations: tolerations})),
+			want: rookEdgefsCluster(withPlacement(rook.PlacementSpec{rook.KeyAll: rook.Placement{Tolerations: tolerations}})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ClusterCrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ClusterCrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsCluster
+		r    *rookv1.Cluster
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    edgefsCluster(),
+			r:    rookEdgefsCluster(),
+			want: false,
+		},
+		"NoUpdateNeededStatusChanged": {
+			c:    edgefsCluster(),
+			r:    rookEdgefsCluster(withState(rookv1.ClusterStateCreated)),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    edgefsCluster(),
+			r:    rookEdgefsCluster(withDirectories(rook.Directory{Path: "/data0"})),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ClusterNeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ClusterNeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsServiceAvailable(t *testing.T) {
+	cases := map[string]struct {
+		d         *appsv1.Deployment
+		instances int32
+		want      bool
+	}{
+		"NotReady": {
+			d:         &appsv1.Deployment{Status: appsv1.DeploymentStatus{ReadyReplicas: 1}},
+			instances: 2,
+			want:      false,
+		},
+		"Ready": {
+			d:         &appsv1.Deployment{Status: appsv1.DeploymentStatus{ReadyReplicas: 2}},
+			instances: 2,
+			want:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServiceAvailable(tc.d, tc.instances)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsServiceAvailable(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
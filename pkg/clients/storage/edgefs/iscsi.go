@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"reflect"
+
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// ISCSI is the EdgeFS iSCSI service kind.
+var ISCSI = ServiceKind{
+	Name:        "iSCSI",
+	NamePrefix:  "rook-edgefs-iscsi-",
+	DefaultPort: 3260,
+	Types:       []runtime.Object{&rookv1.ISCSI{}, &rookv1.ISCSIList{}},
+	Adapt: func(mg resource.Managed) (Service, bool) {
+		c, ok := mg.(*v1alpha1.EdgefsISCSI)
+		if !ok {
+			return nil, false
+		}
+		return iscsi{c}, true
+	},
+}
+
+type iscsi struct {
+	*v1alpha1.EdgefsISCSI
+}
+
+func (s iscsi) Parameters() ServiceParameters {
+	p := s.Spec.EdgefsISCSIParameters
+	return ServiceParameters{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Instances: p.Instances,
+	}
+}
+
+func (s iscsi) CrossToRook() RookService { return ISCSICrossToRook(s.EdgefsISCSI) }
+
+func (s iscsi) NeedsUpdate(e RookService) bool {
+	return ISCSINeedsUpdate(s.EdgefsISCSI, e.(*rookv1.ISCSI))
+}
+
+func (iscsi) Rook() RookService { return &rookv1.ISCSI{} }
+
+// ISCSICrossToRook converts a Crossplane EdgeFS iSCSI object to a Rook EdgeFS
+// iSCSI object.
+func ISCSICrossToRook(c *v1alpha1.EdgefsISCSI) *rookv1.ISCSI {
+	params := c.Spec.EdgefsISCSIParameters
+	return &rookv1.ISCSI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1.ISCSISpec{
+			Annotations:     rook.Annotations(params.Annotations),
+			Placement:       convertPlacement(params.Placement),
+			Resources:       params.Resources,
+			Instances:       params.Instances,
+			TargetName:      params.TargetName,
+			TargetParams:    convertTargetParams(params.TargetParams),
+			ResourceProfile: params.ResourceProfile,
+		},
+	}
+}
+
+// ISCSINeedsUpdate determines whether the external Rook EdgeFS iSCSI needs to
+// be updated.
+func ISCSINeedsUpdate(c *v1alpha1.EdgefsISCSI, e *rookv1.ISCSI) bool {
+	params := c.Spec.EdgefsISCSIParameters
+	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if !reflect.DeepEqual(convertPlacement(params.Placement), e.Spec.Placement) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Resources, e.Spec.Resources) {
+		return true
+	}
+	if params.Instances != e.Spec.Instances {
+		return true
+	}
+	if params.TargetName != e.Spec.TargetName {
+		return true
+	}
+	if convertTargetParams(params.TargetParams) != e.Spec.TargetParams {
+		return true
+	}
+	if params.ResourceProfile != e.Spec.ResourceProfile {
+		return true
+	}
+	return false
+}
+
+func convertTargetParams(p v1alpha1.TargetParametersSpec) rookv1.TargetParametersSpec {
+	return rookv1.TargetParametersSpec{
+		MaxRecvDataSegmentLength: p.MaxRecvDataSegmentLength,
+		DefaultTime2Retain:       p.DefaultTime2Retain,
+		DefaultTime2Wait:         p.DefaultTime2Wait,
+		FirstBurstLength:         p.FirstBurstLength,
+		MaxBurstLength:           p.MaxBurstLength,
+		MaxQueueCmd:              p.MaxQueueCmd,
+	}
+}
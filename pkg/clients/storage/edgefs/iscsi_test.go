@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+type edgefsISCSIModifier func(*v1alpha1.EdgefsISCSI)
+
+func withEdgefsISCSIInstances(n int32) edgefsISCSIModifier {
+	return func(i *v1alpha1.EdgefsISCSI) { i.Spec.EdgefsISCSIParameters.Instances = n }
+}
+
+func withEdgefsISCSIPlacement(p *corev1alpha1.Placement) edgefsISCSIModifier {
+	return func(i *v1alpha1.EdgefsISCSI) { i.Spec.EdgefsISCSIParameters.Placement = p }
+}
+
+func edgefsISCSI(im ...edgefsISCSIModifier) *v1alpha1.EdgefsISCSI {
+	i := &v1alpha1.EdgefsISCSI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.EdgefsISCSISpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			EdgefsISCSIParameters: v1alpha1.EdgefsISCSIParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Instances:   1,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookEdgefsISCSIModifier func(*rookv1.ISCSI)
+
+func withISCSIInstances(n int32) rookEdgefsISCSIModifier {
+	return func(i *rookv1.ISCSI) { i.Spec.Instances = n }
+}
+
+func withISCSIPlacement(p rook.Placement) rookEdgefsISCSIModifier {
+	return func(i *rookv1.ISCSI) { i.Spec.Placement = p }
+}
+
+func rookEdgefsISCSI(im ...rookEdgefsISCSIModifier) *rookv1.ISCSI {
+	i := &rookv1.ISCSI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1.ISCSISpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Instances:   1,
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestISCSICrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsISCSI
+		want *rookv1.ISCSI
+	}{
+		"Successful": {
+			c:    edgefsISCSI(),
+			want: rookEdgefsISCSI(),
+		},
+		"SuccessfulWithModifier": {
+			c:    edgefsISCSI(withEdgefsISCSIInstances(3)),
+			want: rookEdgefsISCSI(withISCSIInstances(3)),
+		},
+		"SuccessfulWithPlacement": {
+			c:    edgefsISCSI(withEdgefsISCSIPlacement(&corev1alpha1.Placement{Tolerations: tolerations})),
+			want: rookEdgefsISCSI(withISCSIPlacement(rook.Placement{Tolerations: tolerations})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ISCSICrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ISCSICrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestISCSINeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsISCSI
+		r    *rookv1.ISCSI
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    edgefsISCSI(),
+			r:    rookEdgefsISCSI(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    edgefsISCSI(),
+			r:    rookEdgefsISCSI(withISCSIInstances(3)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ISCSINeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ISCSINeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestISCSIConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsISCSI
+		want managed.ConnectionDetails
+	}{
+		"Successful": {
+			c: edgefsISCSI(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("rook-edgefs-iscsi-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("3260"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ISCSI.ConnectionDetails(iscsi{tc.c}.Parameters())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ISCSI.ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
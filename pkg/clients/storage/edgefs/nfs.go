@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"reflect"
+
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// NFS is the EdgeFS NFS service kind.
+var NFS = ServiceKind{
+	Name:        "NFS",
+	NamePrefix:  "rook-edgefs-nfs-",
+	DefaultPort: 2049,
+	Types:       []runtime.Object{&rookv1.NFS{}, &rookv1.NFSList{}},
+	Adapt: func(mg resource.Managed) (Service, bool) {
+		c, ok := mg.(*v1alpha1.EdgefsNFS)
+		if !ok {
+			return nil, false
+		}
+		return nfs{c}, true
+	},
+}
+
+type nfs struct {
+	*v1alpha1.EdgefsNFS
+}
+
+func (s nfs) Parameters() ServiceParameters {
+	p := s.Spec.EdgefsNFSParameters
+	return ServiceParameters{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Instances: p.Instances,
+	}
+}
+
+func (s nfs) CrossToRook() RookService { return NFSCrossToRook(s.EdgefsNFS) }
+
+func (s nfs) NeedsUpdate(e RookService) bool {
+	return NFSNeedsUpdate(s.EdgefsNFS, e.(*rookv1.NFS))
+}
+
+func (nfs) Rook() RookService { return &rookv1.NFS{} }
+
+// NFSCrossToRook converts a Crossplane EdgeFS NFS object to a Rook EdgeFS NFS
+// object.
+func NFSCrossToRook(c *v1alpha1.EdgefsNFS) *rookv1.NFS {
+	params := c.Spec.EdgefsNFSParameters
+	return &rookv1.NFS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1.NFSSpec{
+			Annotations:       rook.Annotations(params.Annotations),
+			Placement:         convertPlacement(params.Placement),
+			Resources:         params.Resources,
+			Instances:         params.Instances,
+			RelaxedDirUpdates: params.RelaxedDirUpdates,
+			ResourceProfile:   params.ResourceProfile,
+		},
+	}
+}
+
+// NFSNeedsUpdate determines whether the external Rook EdgeFS NFS needs to be
+// updated.
+func NFSNeedsUpdate(c *v1alpha1.EdgefsNFS, e *rookv1.NFS) bool {
+	params := c.Spec.EdgefsNFSParameters
+	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if !reflect.DeepEqual(convertPlacement(params.Placement), e.Spec.Placement) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Resources, e.Spec.Resources) {
+		return true
+	}
+	if params.Instances != e.Spec.Instances {
+		return true
+	}
+	if params.RelaxedDirUpdates != e.Spec.RelaxedDirUpdates {
+		return true
+	}
+	if params.ResourceProfile != e.Spec.ResourceProfile {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+type edgefsNFSModifier func(*v1alpha1.EdgefsNFS)
+
+func withEdgefsNFSInstances(n int32) edgefsNFSModifier {
+	return func(i *v1alpha1.EdgefsNFS) { i.Spec.EdgefsNFSParameters.Instances = n }
+}
+
+func withEdgefsNFSPlacement(p *corev1alpha1.Placement) edgefsNFSModifier {
+	return func(i *v1alpha1.EdgefsNFS) { i.Spec.EdgefsNFSParameters.Placement = p }
+}
+
+func edgefsNFS(im ...edgefsNFSModifier) *v1alpha1.EdgefsNFS {
+	i := &v1alpha1.EdgefsNFS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.EdgefsNFSSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			EdgefsNFSParameters: v1alpha1.EdgefsNFSParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Instances:   1,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookEdgefsNFSModifier func(*rookv1.NFS)
+
+func withNFSInstances(n int32) rookEdgefsNFSModifier {
+	return func(i *rookv1.NFS) { i.Spec.Instances = n }
+}
+
+func withNFSPlacement(p rook.Placement) rookEdgefsNFSModifier {
+	return func(i *rookv1.NFS) { i.Spec.Placement = p }
+}
+
+func rookEdgefsNFS(im ...rookEdgefsNFSModifier) *rookv1.NFS {
+	i := &rookv1.NFS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1.NFSSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Instances:   1,
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestNFSCrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsNFS
+		want *rookv1.NFS
+	}{
+		"Successful": {
+			c:    edgefsNFS(),
+			want: rookEdgefsNFS(),
+		},
+		"SuccessfulWithModifier": {
+			c:    edgefsNFS(withEdgefsNFSInstances(3)),
+			want: rookEdgefsNFS(withNFSInstances(3)),
+		},
+		"SuccessfulWithPlacement": {
+			c:    edgefsNFS(withEdgefsNFSPlacement(&corev1alpha1.Placement{Tolerations: tolerations})),
+			want: rookEdgefsNFS(withNFSPlacement(rook.Placement{Tolerations: tolerations})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NFSCrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NFSCrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNFSNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsNFS
+		r    *rookv1.NFS
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    edgefsNFS(),
+			r:    rookEdgefsNFS(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    edgefsNFS(),
+			r:    rookEdgefsNFS(withNFSInstances(3)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NFSNeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NFSNeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNFSConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsNFS
+		want managed.ConnectionDetails
+	}{
+		"Successful": {
+			c: edgefsNFS(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("rook-edgefs-nfs-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("2049"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NFS.ConnectionDetails(nfs{tc.c}.Parameters())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NFS.ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"reflect"
+
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// S3 is the EdgeFS S3 service kind.
+var S3 = ServiceKind{
+	Name:        "S3",
+	NamePrefix:  "rook-edgefs-s3-",
+	DefaultPort: 9982,
+	Types:       []runtime.Object{&rookv1.S3{}, &rookv1.S3List{}},
+	Adapt: func(mg resource.Managed) (Service, bool) {
+		c, ok := mg.(*v1alpha1.EdgefsS3)
+		if !ok {
+			return nil, false
+		}
+		return s3{c}, true
+	},
+}
+
+type s3 struct {
+	*v1alpha1.EdgefsS3
+}
+
+func (s s3) Parameters() ServiceParameters {
+	p := s.Spec.EdgefsS3Parameters
+	return ServiceParameters{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Instances: p.Instances,
+		Port:      p.Port,
+	}
+}
+
+func (s s3) CrossToRook() RookService { return S3CrossToRook(s.EdgefsS3) }
+
+func (s s3) NeedsUpdate(e RookService) bool {
+	return S3NeedsUpdate(s.EdgefsS3, e.(*rookv1.S3))
+}
+
+func (s3) Rook() RookService { return &rookv1.S3{} }
+
+// S3CrossToRook converts a Crossplane EdgeFS S3 object to a Rook EdgeFS S3
+// object.
+func S3CrossToRook(c *v1alpha1.EdgefsS3) *rookv1.S3 {
+	params := c.Spec.EdgefsS3Parameters
+	return &rookv1.S3{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1.S3Spec{
+			Annotations:        rook.Annotations(params.Annotations),
+			Placement:          convertPlacement(params.Placement),
+			Resources:          params.Resources,
+			Instances:          params.Instances,
+			Port:               params.Port,
+			SecurePort:         params.SecurePort,
+			ServiceType:        params.ServiceType,
+			ExternalPort:       params.ExternalPort,
+			SecureExternalPort: params.SecureExternalPort,
+			SSLCertificateRef:  params.SSLCertificateRef,
+			S3Type:             params.S3Type,
+			ResourceProfile:    params.ResourceProfile,
+		},
+	}
+}
+
+// S3NeedsUpdate determines whether the external Rook EdgeFS S3 needs to be
+// updated.
+func S3NeedsUpdate(c *v1alpha1.EdgefsS3, e *rookv1.S3) bool {
+	params := c.Spec.EdgefsS3Parameters
+	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if !reflect.DeepEqual(convertPlacement(params.Placement), e.Spec.Placement) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Resources, e.Spec.Resources) {
+		return true
+	}
+	if params.Instances != e.Spec.Instances {
+		return true
+	}
+	if params.Port != e.Spec.Port {
+		return true
+	}
+	if params.SecurePort != e.Spec.SecurePort {
+		return true
+	}
+	if params.ServiceType != e.Spec.ServiceType {
+		return true
+	}
+	if params.ExternalPort != e.Spec.ExternalPort {
+		return true
+	}
+	if params.SecureExternalPort != e.Spec.SecureExternalPort {
+		return true
+	}
+	if params.SSLCertificateRef != e.Spec.SSLCertificateRef {
+		return true
+	}
+	if params.S3Type != e.Spec.S3Type {
+		return true
+	}
+	if params.ResourceProfile != e.Spec.ResourceProfile {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+type edgefsS3Modifier func(*v1alpha1.EdgefsS3)
+
+func withEdgefsS3Instances(n int32) edgefsS3Modifier {
+	return func(i *v1alpha1.EdgefsS3) { i.Spec.EdgefsS3Parameters.Instances = n }
+}
+
+func withEdgefsS3Placement(p *corev1alpha1.Placement) edgefsS3Modifier {
+	return func(i *v1alpha1.EdgefsS3) { i.Spec.EdgefsS3Parameters.Placement = p }
+}
+
+func edgefsS3(im ...edgefsS3Modifier) *v1alpha1.EdgefsS3 {
+	i := &v1alpha1.EdgefsS3{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.EdgefsS3Spec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			EdgefsS3Parameters: v1alpha1.EdgefsS3Parameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Instances:   1,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func withEdgefsS3Port(p uint) edgefsS3Modifier {
+	return func(i *v1alpha1.EdgefsS3) { i.Spec.EdgefsS3Parameters.Port = p }
+}
+
+type rookEdgefsS3Modifier func(*rookv1.S3)
+
+func withS3Instances(n int32) rookEdgefsS3Modifier {
+	return func(i *rookv1.S3) { i.Spec.Instances = n }
+}
+
+func withS3Placement(p rook.Placement) rookEdgefsS3Modifier {
+	return func(i *rookv1.S3) { i.Spec.Placement = p }
+}
+
+func rookEdgefsS3(im ...rookEdgefsS3Modifier) *rookv1.S3 {
+	i := &rookv1.S3{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1.S3Spec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Instances:   1,
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestS3CrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsS3
+		want *rookv1.S3
+	}{
+		"Successful": {
+			c:    edgefsS3(),
+			want: rookEdgefsS3(),
+		},
+		"SuccessfulWithModifier": {
+			c:    edgefsS3(withEdgefsS3Instances(3)),
+			want: rookEdgefsS3(withS3Instances(3)),
+		},
+		"SuccessfulWithPlacement": {
+			c:    edgefsS3(withEdgefsS3Placement(&corev1alpha1.Placement{Tolerations: tolerations})),
+			want: rookEdgefsS3(withS3Placement(rook.Placement{Tolerations: tolerations})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := S3CrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("S3CrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestS3NeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsS3
+		r    *rookv1.S3
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    edgefsS3(),
+			r:    rookEdgefsS3(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    edgefsS3(),
+			r:    rookEdgefsS3(withS3Instances(3)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := S3NeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("S3NeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestS3ConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsS3
+		want managed.ConnectionDetails
+	}{
+		"DefaultPort": {
+			c: edgefsS3(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("rook-edgefs-s3-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("9982"),
+			},
+		},
+		"CustomPort": {
+			c: edgefsS3(withEdgefsS3Port(8080)),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("rook-edgefs-s3-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("8080"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := S3.ConnectionDetails(s3{tc.c}.Parameters())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("S3.ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/pkg/clients"
+)
+
+// Error strings.
+const (
+	errNewServiceClient   = "cannot create new Kubernetes client"
+	errNoClusterNamespace = "namespace of the EdgeFS cluster is not set"
+
+	errFmtNotService    = "managed resource is not an EdgeFS %s service"
+	errFmtGetService    = "cannot get EdgeFS %s service in target Kubernetes cluster"
+	errFmtGetDeployment = "cannot get EdgeFS %s deployment in target Kubernetes cluster"
+	errFmtCreateService = "cannot create EdgeFS %s service in target Kubernetes cluster"
+	errFmtUpdateService = "cannot update EdgeFS %s service in target Kubernetes cluster"
+	errFmtDeleteService = "cannot delete EdgeFS %s service in target Kubernetes cluster"
+)
+
+// ServiceParameters are the parameters that all kinds of EdgeFS service have
+// in common.
+type ServiceParameters struct {
+	Name      string
+	Namespace string
+	Instances int32
+
+	// Port on which the service is exposed, or zero if the service is
+	// exposed on the default port of its kind.
+	Port uint
+}
+
+// A RookService is a Rook EdgeFS service object, for example a Rook EdgeFS
+// NFS.
+type RookService interface {
+	runtime.Object
+	metav1.Object
+}
+
+// A Service is an EdgeFS service managed resource of a particular kind.
+type Service interface {
+	resource.Managed
+
+	// Parameters returns the parameters of this service.
+	Parameters() ServiceParameters
+
+	// CrossToRook converts this service to the Rook EdgeFS service object
+	// that backs it.
+	CrossToRook() RookService
+
+	// NeedsUpdate determines whether the supplied Rook EdgeFS service object
+	// that backs this service needs to be updated.
+	NeedsUpdate(e RookService) bool
+
+	// Rook returns an empty Rook EdgeFS service object of the kind that
+	// backs this service.
+	Rook() RookService
+}
+
+// A ServiceKind is a kind of EdgeFS service, for example NFS or S3.
+type ServiceKind struct {
+	// Name of the kind, as used in error messages.
+	Name string
+
+	// NamePrefix of the Deployment and Service the Rook EdgeFS operator
+	// creates for each service of this kind.
+	NamePrefix string
+
+	// DefaultPort on which the Rook EdgeFS operator exposes services of
+	// this kind unless another port is specified.
+	DefaultPort uint
+
+	// Types are the Rook EdgeFS service object and list types of this kind.
+	Types []runtime.Object
+
+	// Adapt returns the supplied managed resource as a Service, or false if
+	// it is not a service of this kind.
+	Adapt func(mg resource.Managed) (Service, bool)
+}
+
+// DeploymentName returns the name of the Deployment and Service the Rook
+// EdgeFS operator creates for the supplied service of this kind.
+func (k ServiceKind) DeploymentName(p ServiceParameters) string {
+	return k.NamePrefix + p.Name
+}
+
+// ConnectionDetails returns the connection details of the supplied service
+// of this kind.
+func (k ServiceKind) ConnectionDetails(p ServiceParameters) managed.ConnectionDetails {
+	port := p.Port
+	if port == 0 {
+		port = k.DefaultPort
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(fmt.Sprintf("%s.%s.svc", k.DeploymentName(p), p.Namespace)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatUint(uint64(port), 10)),
+	}
+}
+
+// NewServiceConnecter returns a managed.ExternalConnecter that connects to
+// the Kubernetes cluster in which EdgeFS services of the supplied kind run.
+func NewServiceConnecter(c client.Client, k ServiceKind) managed.ExternalConnecter {
+	return &serviceConnecter{client: c, kind: k}
+}
+
+type serviceConnecter struct {
+	client client.Client
+	kind   ServiceKind
+}
+
+func (c *serviceConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(rookv1.SchemeGroupVersion, c.kind.Types...)
+	metav1.AddToGroupVersion(scheme, rookv1.SchemeGroupVersion)
+	scheme.AddKnownTypes(appsv1.SchemeGroupVersion,
+		&appsv1.Deployment{},
+	)
+	metav1.AddToGroupVersion(scheme, appsv1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &serviceExternal{client: cl, kind: c.kind}, errors.Wrap(err, errNewServiceClient)
+}
+
+type serviceExternal struct {
+	client client.Client
+	kind   ServiceKind
+}
+
+func (e *serviceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	s, ok := e.kind.Adapt(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.Errorf(errFmtNotService, e.kind.Name)
+	}
+	p := s.Parameters()
+
+	// The namespace is usually resolved from the referenced EdgefsCluster
+	// before we are called.
+	if p.Namespace == "" {
+		return managed.ExternalObservation{}, errors.New(errNoClusterNamespace)
+	}
+
+	key := types.NamespacedName{Name: p.Name, Namespace: p.Namespace}
+
+	err := e.client.Get(ctx, key, s.Rook())
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errFmtGetService, e.kind.Name)
+	}
+
+	// Rook EdgeFS services have no status, so we consider a service
+	// available once all instances of the Deployment that backs it are
+	// ready.
+	d := &appsv1.Deployment{}
+	dkey := types.NamespacedName{Name: e.kind.DeploymentName(p), Namespace: p.Namespace}
+	err = e.client.Get(ctx, dkey, d)
+	if resource.IgnoreNotFound(err) != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errFmtGetDeployment, e.kind.Name)
+	}
+
+	switch {
+	case err == nil && IsServiceAvailable(d, p.Instances):
+		s.SetConditions(xpv1.Available())
+	default:
+		s.SetConditions(xpv1.Creating())
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: e.kind.ConnectionDetails(p),
+	}
+
+	return o, nil
+}
+
+func (e *serviceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	s, ok := e.kind.Adapt(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.Errorf(errFmtNotService, e.kind.Name)
+	}
+
+	s.SetConditions(xpv1.Creating())
+
+	err := e.client.Create(ctx, s.CrossToRook())
+	return managed.ExternalCreation{}, errors.Wrapf(err, errFmtCreateService, e.kind.Name)
+}
+
+func (e *serviceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	s, ok := e.kind.Adapt(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.Errorf(errFmtNotService, e.kind.Name)
+	}
+	p := s.Parameters()
+
+	key := types.NamespacedName{Name: p.Name, Namespace: p.Namespace}
+
+	external := s.Rook()
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtGetService, e.kind.Name)
+	}
+
+	if !s.NeedsUpdate(external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	update := s.CrossToRook()
+	update.SetResourceVersion(external.GetResourceVersion())
+	err := e.client.Update(ctx, update)
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errFmtUpdateService, e.kind.Name)
+}
+
+func (e *serviceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	s, ok := e.kind.Adapt(mg)
+	if !ok {
+		return errors.Errorf(errFmtNotService, e.kind.Name)
+	}
+	p := s.Parameters()
+
+	s.SetConditions(xpv1.Deleting())
+
+	key := types.NamespacedName{Name: p.Name, Namespace: p.Namespace}
+
+	external := s.Rook()
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, errFmtGetService, e.kind.Name)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrapf(err, errFmtDeleteService, e.kind.Name)
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+var errorBoom = errors.New("boom")
+var errorServiceNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "edgefs.rook.io",
+		Resource: "NFS"},
+	"boom")
+
+var serviceConnectionDetails = managed.ConnectionDetails{
+	xpv1.ResourceCredentialsSecretEndpointKey: []byte("rook-edgefs-nfs-cool-name.cool-namespace.svc"),
+	xpv1.ResourceCredentialsSecretPortKey:     []byte("2049"),
+}
+
+type serviceStrange struct {
+	resource.Managed
+}
+
+func withEdgefsNFSConditions(c ...xpv1.Condition) edgefsNFSModifier {
+	return func(i *v1alpha1.EdgefsNFS) { i.Status.SetConditions(c...) }
+}
+
+func withEdgefsNFSNamespace(ns string) edgefsNFSModifier {
+	return func(i *v1alpha1.EdgefsNFS) { i.Spec.EdgefsNFSParameters.Namespace = ns }
+}
+
+func withNFSResourceVersion(v string) rookEdgefsNFSModifier {
+	return func(i *rookv1.NFS) { i.SetResourceVersion(v) }
+}
+
+var _ managed.ExternalClient = &serviceExternal{}
+var _ managed.ExternalConnecter = &serviceConnecter{}
+
+func TestServiceObserve(t *testing.T) {
+	deployment := client.ObjectKey{Namespace: namespace, Name: "rook-edgefs-nfs-cool-name"}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedServiceAvailable": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *rookv1.NFS:
+						*o = *rookEdgefsNFS()
+					case *appsv1.Deployment:
+						if key != deployment {
+							return errorServiceNotFound
+						}
+						o.Status.ReadyReplicas = 1
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(withEdgefsNFSConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: serviceConnectionDetails,
+				},
+			},
+		},
+		"ObservedServiceNotReady": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if o, ok := obj.(*rookv1.NFS); ok {
+						*o = *rookEdgefsNFS()
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(withEdgefsNFSConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: serviceConnectionDetails,
+				},
+			},
+		},
+		"ObservedDeploymentDoesNotExist": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*appsv1.Deployment); ok {
+						return errorServiceNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(withEdgefsNFSConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: serviceConnectionDetails,
+				},
+			},
+		},
+		"FailedToGetDeployment": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*appsv1.Deployment); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(),
+				err: errors.Wrapf(errorBoom, errFmtGetDeployment, "NFS"),
+			},
+		},
+		"ObservedServiceDoesNotExist": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorServiceNotFound),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:          edgefsNFS(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToGetService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorBoom),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(),
+				err: errors.Wrapf(errorBoom, errFmtGetService, "NFS"),
+			},
+		},
+		"NoClusterNamespace": {
+			client: &serviceExternal{kind: NFS},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(withEdgefsNFSNamespace("")),
+			},
+			want: want{
+				mg:  edgefsNFS(withEdgefsNFSNamespace("")),
+				err: errors.New(errNoClusterNamespace),
+			},
+		},
+		"NotService": {
+			client: &serviceExternal{kind: NFS},
+			args: args{
+				ctx: context.Background(),
+				mg:  &serviceStrange{},
+			},
+			want: want{
+				mg:  &serviceStrange{},
+				err: errors.Errorf(errFmtNotService, "NFS"),
+			},
+		},
+		"NotServiceOfKind": {
+			client: &serviceExternal{kind: S3},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(),
+				err: errors.Errorf(errFmtNotService, "S3"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceCreate(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					if diff := cmp.Diff(rookEdgefsNFS(), obj); diff != "" {
+						t.Errorf("MockCreate: -want, +got:\n%s", diff)
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(withEdgefsNFSConditions(xpv1.Creating())),
+			},
+		},
+		"NotService": {
+			client: &serviceExternal{kind: NFS},
+			args: args{
+				ctx: context.Background(),
+				mg:  &serviceStrange{},
+			},
+			want: want{
+				mg:  &serviceStrange{},
+				err: errors.Errorf(errFmtNotService, "NFS"),
+			},
+		},
+		"FailedToCreateService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockCreate: test.NewMockCreateFn(errorBoom),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(withEdgefsNFSConditions(xpv1.Creating())),
+				err: errors.Wrapf(errorBoom, errFmtCreateService, "NFS"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceUpdate(t *testing.T) {
+	getService := func(rm ...rookEdgefsNFSModifier) func(context.Context, client.ObjectKey, runtime.Object) error {
+		return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+			if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+				*obj.(*rookv1.NFS) = *rookEdgefsNFS(rm...)
+			}
+			return nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"UpdatedService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: getService(withNFSInstances(3), withNFSResourceVersion("42")),
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					if diff := cmp.Diff(rookEdgefsNFS(withNFSResourceVersion("42")), obj); diff != "" {
+						t.Errorf("MockUpdate: -want, +got:\n%s", diff)
+					}
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: getService(),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(),
+			},
+		},
+		"NotService": {
+			client: &serviceExternal{kind: NFS},
+			args: args{
+				ctx: context.Background(),
+				mg:  &serviceStrange{},
+			},
+			want: want{
+				mg:  &serviceStrange{},
+				err: errors.Errorf(errFmtNotService, "NFS"),
+			},
+		},
+		"FailedToGetService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorBoom),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(),
+				err: errors.Wrapf(errorBoom, errFmtGetService, "NFS"),
+			},
+		},
+		"FailedToUpdateService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet:    getService(withNFSInstances(3)),
+				MockUpdate: test.NewMockUpdateFn(errorBoom),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(),
+				err: errors.Wrapf(errorBoom, errFmtUpdateService, "NFS"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceDelete(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockDelete: test.NewMockDeleteFn(nil),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(withEdgefsNFSConditions(xpv1.Deleting())),
+			},
+		},
+		"ServiceAlreadyDeleted": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorServiceNotFound),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg: edgefsNFS(withEdgefsNFSConditions(xpv1.Deleting())),
+			},
+		},
+		"NotService": {
+			client: &serviceExternal{kind: NFS},
+			args: args{
+				ctx: context.Background(),
+				mg:  &serviceStrange{},
+			},
+			want: want{
+				mg:  &serviceStrange{},
+				err: errors.Errorf(errFmtNotService, "NFS"),
+			},
+		},
+		"FailedToGetService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorBoom),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(withEdgefsNFSConditions(xpv1.Deleting())),
+				err: errors.Wrapf(errorBoom, errFmtGetService, "NFS"),
+			},
+		},
+		"FailedToDeleteService": {
+			client: &serviceExternal{kind: NFS, client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockDelete: test.NewMockDeleteFn(errorBoom),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsNFS(),
+			},
+			want: want{
+				mg:  edgefsNFS(withEdgefsNFSConditions(xpv1.Deleting())),
+				err: errors.Wrapf(errorBoom, errFmtDeleteService, "NFS"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"reflect"
+
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// SWIFT is the EdgeFS SWIFT service kind.
+var SWIFT = ServiceKind{
+	Name:        "SWIFT",
+	NamePrefix:  "rook-edgefs-swift-",
+	DefaultPort: 9981,
+	Types:       []runtime.Object{&rookv1.SWIFT{}, &rookv1.SWIFTList{}},
+	Adapt: func(mg resource.Managed) (Service, bool) {
+		c, ok := mg.(*v1alpha1.EdgefsSWIFT)
+		if !ok {
+			return nil, false
+		}
+		return swift{c}, true
+	},
+}
+
+type swift struct {
+	*v1alpha1.EdgefsSWIFT
+}
+
+func (s swift) Parameters() ServiceParameters {
+	p := s.Spec.EdgefsSWIFTParameters
+	return ServiceParameters{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Instances: p.Instances,
+		Port:      p.Port,
+	}
+}
+
+func (s swift) CrossToRook() RookService { return SWIFTCrossToRook(s.EdgefsSWIFT) }
+
+func (s swift) NeedsUpdate(e RookService) bool {
+	return SWIFTNeedsUpdate(s.EdgefsSWIFT, e.(*rookv1.SWIFT))
+}
+
+func (swift) Rook() RookService { return &rookv1.SWIFT{} }
+
+// SWIFTCrossToRook converts a Crossplane EdgeFS SWIFT object to a Rook EdgeFS
+// SWIFT object.
+func SWIFTCrossToRook(c *v1alpha1.EdgefsSWIFT) *rookv1.SWIFT {
+	params := c.Spec.EdgefsSWIFTParameters
+	return &rookv1.SWIFT{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1.SWIFTSpec{
+			Placement:          convertPlacement(params.Placement),
+			Resources:          params.Resources,
+			Instances:          params.Instances,
+			Port:               params.Port,
+			SecurePort:         params.SecurePort,
+			ServiceType:        params.ServiceType,
+			ExternalPort:       params.ExternalPort,
+			SecureExternalPort: params.SecureExternalPort,
+			SSLCertificateRef:  params.SSLCertificateRef,
+			ResourceProfile:    params.ResourceProfile,
+		},
+	}
+}
+
+// SWIFTNeedsUpdate determines whether the external Rook EdgeFS SWIFT needs to
+// be updated.
+func SWIFTNeedsUpdate(c *v1alpha1.EdgefsSWIFT, e *rookv1.SWIFT) bool {
+	params := c.Spec.EdgefsSWIFTParameters
+	if !reflect.DeepEqual(convertPlacement(params.Placement), e.Spec.Placement) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Resources, e.Spec.Resources) {
+		return true
+	}
+	if params.Instances != e.Spec.Instances {
+		return true
+	}
+	if params.Port != e.Spec.Port {
+		return true
+	}
+	if params.SecurePort != e.Spec.SecurePort {
+		return true
+	}
+	if params.ServiceType != e.Spec.ServiceType {
+		return true
+	}
+	if params.ExternalPort != e.Spec.ExternalPort {
+		return true
+	}
+	if params.SecureExternalPort != e.Spec.SecureExternalPort {
+		return true
+	}
+	if params.SSLCertificateRef != e.Spec.SSLCertificateRef {
+		return true
+	}
+	if params.ResourceProfile != e.Spec.ResourceProfile {
+		return true
+	}
+	return false
+}
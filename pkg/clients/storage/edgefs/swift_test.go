@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgefs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+type edgefsSWIFTModifier func(*v1alpha1.EdgefsSWIFT)
+
+func withEdgefsSWIFTInstances(n int32) edgefsSWIFTModifier {
+	return func(i *v1alpha1.EdgefsSWIFT) { i.Spec.EdgefsSWIFTParameters.Instances = n }
+}
+
+func withEdgefsSWIFTPlacement(p *corev1alpha1.Placement) edgefsSWIFTModifier {
+	return func(i *v1alpha1.EdgefsSWIFT) { i.Spec.EdgefsSWIFTParameters.Placement = p }
+}
+
+func edgefsSWIFT(im ...edgefsSWIFTModifier) *v1alpha1.EdgefsSWIFT {
+	i := &v1alpha1.EdgefsSWIFT{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.EdgefsSWIFTSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			EdgefsSWIFTParameters: v1alpha1.EdgefsSWIFTParameters{
+				Name:      name,
+				Namespace: namespace,
+				Instances: 1,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookEdgefsSWIFTModifier func(*rookv1.SWIFT)
+
+func withSWIFTInstances(n int32) rookEdgefsSWIFTModifier {
+	return func(i *rookv1.SWIFT) { i.Spec.Instances = n }
+}
+
+func withSWIFTPlacement(p rook.Placement) rookEdgefsSWIFTModifier {
+	return func(i *rookv1.SWIFT) { i.Spec.Placement = p }
+}
+
+func rookEdgefsSWIFT(im ...rookEdgefsSWIFTModifier) *rookv1.SWIFT {
+	i := &rookv1.SWIFT{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1.SWIFTSpec{
+			Instances: 1,
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestSWIFTCrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsSWIFT
+		want *rookv1.SWIFT
+	}{
+		"Successful": {
+			c:    edgefsSWIFT(),
+			want: rookEdgefsSWIFT(),
+		},
+		"SuccessfulWithModifier": {
+			c:    edgefsSWIFT(withEdgefsSWIFTInstances(3)),
+			want: rookEdgefsSWIFT(withSWIFTInstances(3)),
+		},
+		"SuccessfulWithPlacement": {
+			c:    edgefsSWIFT(withEdgefsSWIFTPlacement(&corev1alpha1.Placement{Tolerations: tolerations})),
+			want: rookEdgefsSWIFT(withSWIFTPlacement(rook.Placement{Tolerations: tolerations})),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := SWIFTCrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SWIFTCrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSWIFTNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsSWIFT
+		r    *rookv1.SWIFT
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    edgefsSWIFT(),
+			r:    rookEdgefsSWIFT(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    edgefsSWIFT(),
+			r:    rookEdgefsSWIFT(withSWIFTInstances(3)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := SWIFTNeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SWIFTNeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSWIFTConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.EdgefsSWIFT
+		want managed.ConnectionDetails
+	}{
+		"Successful": {
+			c: edgefsSWIFT(),
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("rook-edgefs-swift-cool-name.cool-namespace.svc"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("9981"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := SWIFT.ConnectionDetails(swift{tc.c}.Parameters())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("SWIFT.ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
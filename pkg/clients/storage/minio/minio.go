@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package minio
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/minio.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// S3Port is the port on which the Rook Minio operator exposes the S3 API.
+const S3Port = 9000
+
+// Keys of the Rook Minio credentials secret.
+const (
+	CredentialsAccessKeyKey = "username"
+	CredentialsSecretKeyKey = "password"
+)
+
+// Keys under which the S3 credentials of an object store are published as
+// connection details.
+const (
+	ConnectionSecretAccessKeyKey = "accessKey"
+	ConnectionSecretSecretKeyKey = "secretKey"
+)
+
+// CrossToRook converts a Crossplane Minio object store object to a Rook
+// Minio object store object.
+func CrossToRook(c *v1alpha1.MinioObjectStore) *rookv1alpha1.ObjectStore {
+	params := c.Spec.MinioObjectStoreParameters
+	return &rookv1alpha1.ObjectStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1alpha1.ObjectStoreSpec{
+			Storage: rook.StorageScopeSpec{
+				NodeCount: params.Storage.NodeCount,
+				Selection: rook.Selection{
+					VolumeClaimTemplates: params.Storage.VolumeClaimTemplates,
+				},
+			},
+			Annotations:   rook.Annotations(params.Annotations),
+			Credentials:   params.Credentials,
+			StorageSize:   params.StorageSize,
+			ClusterDomain: params.ClusterDomain,
+		},
+	}
+}
+
+// NeedsUpdate determines whether the external Rook Minio object store needs
+// to be updated.
+func NeedsUpdate(c *v1alpha1.MinioObjectStore, e *rookv1alpha1.ObjectStore) bool {
+	params := c.Spec.MinioObjectStoreParameters
+	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Storage.NodeCount, e.Spec.Storage.NodeCount) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Storage.VolumeClaimTemplates, e.Spec.Storage.VolumeClaimTemplates) {
+		return true
+	}
+	if !reflect.DeepEqual(params.Credentials, e.Spec.Credentials) {
+		return true
+	}
+	if params.StorageSize != e.Spec.StorageSize {
+		return true
+	}
+	if params.ClusterDomain != e.Spec.ClusterDomain {
+		return true
+	}
+	return false
+}
+
+// ConnectionDetails returns the S3 endpoint of the supplied Minio object
+// store, along with the access and secret keys read from the supplied
+// credentials secret.
+func ConnectionDetails(c *v1alpha1.MinioObjectStore, creds *corev1.Secret) managed.ConnectionDetails {
+	params := c.Spec.MinioObjectStoreParameters
+	domain := params.ClusterDomain
+	if domain == "" {
+		domain = rookv1alpha1.ClusterDomainDefault
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(fmt.Sprintf("%s.%s.svc.%s", params.Name, params.Namespace, domain)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(S3Port)),
+		ConnectionSecretAccessKeyKey:              creds.Data[CredentialsAccessKeyKey],
+		ConnectionSecretSecretKeyKey:              creds.Data[CredentialsSecretKeyKey],
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package minio
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/minio.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+
+	providerName         = "cool-rook"
+	connectionSecretName = "cool-connection-secret"
+	credentialsName      = "cool-credentials"
+)
+
+type minioObjectStoreModifier func(*v1alpha1.MinioObjectStore)
+
+func withMinioNodeCount(c int) minioObjectStoreModifier {
+	return func(i *v1alpha1.MinioObjectStore) { i.Spec.MinioObjectStoreParameters.Storage.NodeCount = c }
+}
+
+func withMinioClusterDomain(d string) minioObjectStoreModifier {
+	return func(i *v1alpha1.MinioObjectStore) { i.Spec.MinioObjectStoreParameters.ClusterDomain = d }
+}
+
+func minioObjectStore(im ...minioObjectStoreModifier) *v1alpha1.MinioObjectStore {
+	i := &v1alpha1.MinioObjectStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.MinioObjectStoreSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			MinioObjectStoreParameters: v1alpha1.MinioObjectStoreParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Storage: corev1alpha1.StorageScopeSpec{
+					NodeCount: 4,
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "rook-minio-test",
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{"ReadWriteOnce"},
+								// Test does not check resource requirements due cmp pkg
+								// inability to compare unexported fields.
+								Resources: corev1.ResourceRequirements{},
+							},
+						},
+					},
+				},
+				Credentials: corev1.SecretReference{Name: credentialsName, Namespace: namespace},
+				StorageSize: "10G",
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookMinioObjectStoreModifier func(*rookv1alpha1.ObjectStore)
+
+func withNodeCount(c int) rookMinioObjectStoreModifier {
+	return func(i *rookv1alpha1.ObjectStore) { i.Spec.Storage.NodeCount = c }
+}
+
+func rookMinioObjectStore(im ...rookMinioObjectStoreModifier) *rookv1alpha1.ObjectStore {
+	i := &rookv1alpha1.ObjectStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1alpha1.ObjectStoreSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Storage: rook.StorageScopeSpec{
+				NodeCount: 4,
+				Selection: rook.Selection{
+					VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "rook-minio-test",
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{"ReadWriteOnce"},
+								// Test does not check resource requirements due cmp pkg
+								// inability to compare unexported fields.
+								Resources: corev1.ResourceRequirements{},
+							},
+						},
+					},
+				},
+			},
+			Credentials: corev1.SecretReference{Name: credentialsName, Namespace: namespace},
+			StorageSize: "10G",
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestCrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.MinioObjectStore
+		want *rookv1alpha1.ObjectStore
+	}{
+		"Successful": {
+			c:    minioObjectStore(),
+			want: rookMinioObjectStore(),
+		},
+		"SuccessfulWithModifier": {
+			c:    minioObjectStore(withMinioNodeCount(6)),
+			want: rookMinioObjectStore(withNodeCount(6)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.MinioObjectStore
+		r    *rookv1alpha1.ObjectStore
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    minioObjectStore(),
+			r:    rookMinioObjectStore(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    minioObjectStore(),
+			r:    rookMinioObjectStore(withNodeCount(6)),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	creds := &corev1.Secret{Data: map[string][]byte{
+		CredentialsAccessKeyKey: []byte("cool-access-key"),
+		CredentialsSecretKeyKey: []byte("cool-secret-key"),
+	}}
+
+	cases := map[string]struct {
+		c    *v1alpha1.MinioObjectStore
+		s    *corev1.Secret
+		want managed.ConnectionDetails
+	}{
+		"DefaultClusterDomain": {
+			c: minioObjectStore(),
+			s: creds,
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-name.cool-namespace.svc.cluster.local"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("9000"),
+				ConnectionSecretAccessKeyKey:              []byte("cool-access-key"),
+				ConnectionSecretSecretKeyKey:              []byte("cool-secret-key"),
+			},
+		},
+		"CustomClusterDomain": {
+			c: minioObjectStore(withMinioClusterDomain("cool.domain")),
+			s: creds,
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-name.cool-namespace.svc.cool.domain"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("9000"),
+				ConnectionSecretAccessKeyKey:              []byte("cool-access-key"),
+				ConnectionSecretSecretKeyKey:              []byte("cool-secret-key"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.c, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfs
+
+import (
+	"reflect"
+	"strconv"
+
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/nfs.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+)
+
+// NFSPort is the port on which the Rook NFS operator exposes NFS.
+const NFSPort = 2049
+
+// CrossToRook converts a Crossplane NFS server object to a Rook NFS server
+// object.
+func CrossToRook(c *v1alpha1.NFSServer) *rookv1alpha1.NFSServer {
+	params := c.Spec.NFSServerParameters
+	return &rookv1alpha1.NFSServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      params.Name,
+			Namespace: params.Namespace,
+		},
+		Spec: rookv1alpha1.NFSServerSpec{
+			Annotations: rook.Annotations(params.Annotations),
+			Replicas:    params.Replicas,
+			Exports:     convertExports(params.Exports),
+		},
+	}
+}
+
+// NeedsUpdate determines whether the external Rook NFS server needs to be
+// updated.
+func NeedsUpdate(c *v1alpha1.NFSServer, e *rookv1alpha1.NFSServer) bool {
+	params := c.Spec.NFSServerParameters
+	if !reflect.DeepEqual(rook.Annotations(params.Annotations), e.Spec.Annotations) {
+		return true
+	}
+	if params.Replicas != e.Spec.Replicas {
+		return true
+	}
+	if !reflect.DeepEqual(convertExports(params.Exports), e.Spec.Exports) {
+		return true
+	}
+	return false
+}
+
+// ConnectionDetails returns the connection details of the supplied Service
+// that the Rook NFS operator created for an NFS server.
+func ConnectionDetails(s *corev1.Service) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(s.Spec.ClusterIP),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(NFSPort)),
+	}
+}
+
+func convertExports(exports []v1alpha1.ExportsSpec) []rookv1alpha1.ExportsSpec {
+	if exports == nil {
+		return nil
+	}
+	rookexports := make([]rookv1alpha1.ExportsSpec, len(exports))
+	for i, e := range exports {
+		rookexports[i] = rookv1alpha1.ExportsSpec{
+			Name: e.Name,
+			Server: rookv1alpha1.ServerSpec{
+				AccessMode:     e.Server.AccessMode,
+				Squash:         e.Server.Squash,
+				AllowedClients: convertAllowedClients(e.Server.AllowedClients),
+			},
+			PersistentVolumeClaim: e.PersistentVolumeClaim,
+		}
+	}
+	return rookexports
+}
+
+func convertAllowedClients(clients []v1alpha1.AllowedClientsSpec) []rookv1alpha1.AllowedClientsSpec {
+	if clients == nil {
+		return nil
+	}
+	rookclients := make([]rookv1alpha1.AllowedClientsSpec, len(clients))
+	for i, c := range clients {
+		rookclients[i] = rookv1alpha1.AllowedClientsSpec{
+			Name:       c.Name,
+			Clients:    c.Clients,
+			AccessMode: c.AccessMode,
+			Squash:     c.Squash,
+		}
+	}
+	return rookclients
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfs
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/nfs.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+
+	providerName         = "cool-rook"
+	connectionSecretName = "cool-connection-secret"
+)
+
+type nfsServerModifier func(*v1alpha1.NFSServer)
+
+func withNFSSquash(s string) nfsServerModifier {
+	return func(i *v1alpha1.NFSServer) { i.Spec.NFSServerParameters.Exports[0].Server.Squash = s }
+}
+
+func nfsServer(im ...nfsServerModifier) *v1alpha1.NFSServer {
+	i := &v1alpha1.NFSServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.NFSServerSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderReference:                &xpv1.Reference{Name: providerName},
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			NFSServerParameters: v1alpha1.NFSServerParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Replicas:    1,
+				Exports: []v1alpha1.ExportsSpec{{
+					Name: "share1",
+					Server: v1alpha1.ServerSpec{
+						AccessMode: "ReadWrite",
+						Squash:     "none",
+						AllowedClients: []v1alpha1.AllowedClientsSpec{{
+							Name:       "group1",
+							Clients:    []string{"172.17.0.5"},
+							AccessMode: "ReadOnly",
+							Squash:     "root",
+						}},
+					},
+					PersistentVolumeClaim: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nfs-default-claim"},
+				}},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookNFSServerModifier func(*rookv1alpha1.NFSServer)
+
+func withSquash(s string) rookNFSServerModifier {
+	return func(i *rookv1alpha1.NFSServer) { i.Spec.Exports[0].Server.Squash = s }
+}
+
+func rookNFSServer(im ...rookNFSServerModifier) *rookv1alpha1.NFSServer {
+	i := &rookv1alpha1.NFSServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: rookv1alpha1.NFSServerSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Replicas:    1,
+			Exports: []rookv1alpha1.ExportsSpec{{
+				Name: "share1",
+				Server: rookv1alpha1.ServerSpec{
+					AccessMode: "ReadWrite",
+					Squash:     "none",
+					AllowedClients: []rookv1alpha1.AllowedClientsSpec{{
+						Name:       "group1",
+						Clients:    []string{"172.17.0.5"},
+						AccessMode: "ReadOnly",
+						Squash:     "root",
+					}},
+				},
+				PersistentVolumeClaim: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nfs-default-claim"},
+			}},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestCrossToRook(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.NFSServer
+		want *rookv1alpha1.NFSServer
+	}{
+		"Successful": {
+			c:    nfsServer(),
+			want: rookNFSServer(),
+		},
+		"SuccessfulWithModifier": {
+			c:    nfsServer(withNFSSquash("all")),
+			want: rookNFSServer(withSquash("all")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CrossToRook(tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CrossToRook(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsUpdate(t *testing.T) {
+	cases := map[string]struct {
+		c    *v1alpha1.NFSServer
+		r    *rookv1alpha1.NFSServer
+		want bool
+	}{
+		"NoUpdateNeeded": {
+			c:    nfsServer(),
+			r:    rookNFSServer(),
+			want: false,
+		},
+		"UpdateNeeded": {
+			c:    nfsServer(),
+			r:    rookNFSServer(withSquash("all")),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsUpdate(tc.c, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		s    *corev1.Service
+		want managed.ConnectionDetails
+	}{
+		"Successful": {
+			s: &corev1.Service{Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.10"}},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.10"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("2049"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ConnectionDetails(tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
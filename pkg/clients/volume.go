@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	errGetVolumeClaim    = "cannot get volume claim"
+	errGetStorageClass   = "cannot get storage class"
+	errExpandVolumeClaim = "cannot expand volume claim"
+
+	errFmtShrinkVolumeClaim = "cannot shrink volume claim %s from %s to %s"
+	errFmtNoStorageClass    = "cannot expand volume claim %s without a storage class"
+	errFmtNoVolumeExpansion = "storage class %s does not allow volume expansion"
+)
+
+// ExpandVolumeClaims expands the named volume claims in the supplied namespace
+// to the storage requested by the supplied template. The volume claim
+// templates of a StatefulSet cannot be changed, so its existing claims must be
+// expanded directly. Claims that do not exist yet are skipped, and no claim is
+// expanded if any would have to shrink. It returns the observed state of each
+// existing claim.
+func ExpandVolumeClaims(ctx context.Context, c client.Client, namespace string, tmpl corev1.PersistentVolumeClaim, names []string) ([]v1alpha1.VolumeClaimObservation, error) {
+	want, ok := tmpl.Spec.Resources.Requests[corev1.ResourceStorage]
+	if !ok {
+		return nil, nil
+	}
+
+	claims := make([]*corev1.PersistentVolumeClaim, 0, len(names))
+	for _, name := range names {
+		pvc := &corev1.PersistentVolumeClaim{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pvc)
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errGetVolumeClaim)
+		}
+		have := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if want.Cmp(have) < 0 {
+			return nil, errors.Errorf(errFmtShrinkVolumeClaim, name, have.String(), want.String())
+		}
+		claims = append(claims, pvc)
+	}
+
+	var obs []v1alpha1.VolumeClaimObservation
+	for _, pvc := range claims {
+		if want.Cmp(pvc.Spec.Resources.Requests[corev1.ResourceStorage]) > 0 {
+			if err := expand(ctx, c, pvc, want); err != nil {
+				return nil, err
+			}
+		}
+		obs = append(obs, observeVolumeClaim(pvc))
+	}
+	return obs, nil
+}
+
+// expand the supplied volume claim to the supplied storage, if its storage
+// class allows it.
+func expand(ctx context.Context, c client.Client, pvc *corev1.PersistentVolumeClaim, storage resource.Quantity) error {
+	name := pvc.Spec.StorageClassName
+	if name == nil || *name == "" {
+		return errors.Errorf(errFmtNoStorageClass, pvc.GetName())
+	}
+
+	sc := &storagev1.StorageClass{}
+	if err := c.Get(ctx, types.NamespacedName{Name: *name}, sc); err != nil {
+		return errors.Wrap(err, errGetStorageClass)
+	}
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		return errors.Errorf(errFmtNoVolumeExpansion, *name)
+	}
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = storage
+	return errors.Wrap(c.Update(ctx, pvc), errExpandVolumeClaim)
+}
+
+func observeVolumeClaim(pvc *corev1.PersistentVolumeClaim) v1alpha1.VolumeClaimObservation {
+	o := v1alpha1.VolumeClaimObservation{Name: pvc.GetName()}
+	if q, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		o.Requested = q.String()
+	}
+	if q, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		o.Capacity = q.String()
+	}
+	for _, c := range pvc.Status.Conditions {
+		if c.Status == corev1.ConditionTrue {
+			o.Resize = string(c.Type)
+		}
+	}
+	return o
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	namespace    = "cool-namespace"
+	storageClass = "cool-class"
+)
+
+var errBoom = errors.New("boom")
+
+func volumeClaim(storage string) *corev1.PersistentVolumeClaim {
+	sc := storageClass
+	return &corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: &sc,
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(storage)},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(storage)},
+		},
+	}
+}
+
+// get returns a MockGet function that gets the supplied volume claims by name,
+// and a storage class that allows volume expansion if expandable is true.
+func get(claims map[string]*corev1.PersistentVolumeClaim, expandable bool) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch o := obj.(type) {
+		case *corev1.PersistentVolumeClaim:
+			pvc, ok := claims[key.Name]
+			if !ok {
+				return kerrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumeclaims"}, key.Name)
+			}
+			*o = *pvc
+			o.SetName(key.Name)
+		case *storagev1.StorageClass:
+			o.AllowVolumeExpansion = &expandable
+		}
+		return nil
+	}
+}
+
+func TestExpandVolumeClaims(t *testing.T) {
+	type want struct {
+		obs     []v1alpha1.VolumeClaimObservation
+		updated []string
+		err     error
+	}
+
+	tmpl := corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+			},
+		},
+	}
+	resizing := volumeClaim("1Gi")
+	resizing.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("2Gi")
+	resizing.Status.Conditions = []corev1.PersistentVolumeClaimCondition{{
+		Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+		Status: corev1.ConditionTrue,
+	}}
+
+	unclassified := volumeClaim("1Gi")
+	unclassified.Spec.StorageClassName = nil
+
+	cases := map[string]struct {
+		get   test.MockGetFn
+		tmpl  corev1.PersistentVolumeClaim
+		names []string
+		want  want
+	}{
+		"NoStorageRequested": {
+			tmpl:  corev1.PersistentVolumeClaim{},
+			names: []string{"a"},
+			want:  want{},
+		},
+		"Expanded": {
+			get: get(map[string]*corev1.PersistentVolumeClaim{
+				"a": volumeClaim("1Gi"),
+				"b": volumeClaim("2Gi"),
+			}, true),
+			tmpl:  tmpl,
+			names: []string{"a", "b", "c"},
+			want: want{
+				obs: []v1alpha1.VolumeClaimObservation{
+					{Name: "a", Requested: "2Gi", Capacity: "1Gi"},
+					{Name: "b", Requested: "2Gi", Capacity: "2Gi"},
+				},
+				updated: []string{"a"},
+			},
+		},
+		"Resizing": {
+			get:   get(map[string]*corev1.PersistentVolumeClaim{"a": resizing}, true),
+			tmpl:  tmpl,
+			names: []string{"a"},
+			want: want{
+				obs: []v1alpha1.VolumeClaimObservation{
+					{Name: "a", Requested: "2Gi", Capacity: "1Gi", Resize: string(corev1.PersistentVolumeClaimFileSystemResizePending)},
+				},
+			},
+		},
+		"Shrink": {
+			get: get(map[string]*corev1.PersistentVolumeClaim{
+				"a": volumeClaim("1Gi"),
+				"b": volumeClaim("4Gi"),
+			}, true),
+			tmpl:  tmpl,
+			names: []string{"a", "b"},
+			want: want{
+				err: errors.Errorf(errFmtShrinkVolumeClaim, "b", "4Gi", "2Gi"),
+			},
+		},
+		"ExpansionNotAllowed": {
+			get:   get(map[string]*corev1.PersistentVolumeClaim{"a": volumeClaim("1Gi")}, false),
+			tmpl:  tmpl,
+			names: []string{"a"},
+			want: want{
+				err: errors.Errorf(errFmtNoVolumeExpansion, storageClass),
+			},
+		},
+		"NoStorageClass": {
+			get:   get(map[string]*corev1.PersistentVolumeClaim{"a": unclassified}, true),
+			tmpl:  tmpl,
+			names: []string{"a"},
+			want: want{
+				err: errors.Errorf(errFmtNoStorageClass, "a"),
+			},
+		},
+		"FailedToGetVolumeClaim": {
+			get:   test.NewMockGetFn(errBoom),
+			tmpl:  tmpl,
+			names: []string{"a"},
+			want: want{
+				err: errors.Wrap(errBoom, errGetVolumeClaim),
+			},
+		},
+		"FailedToGetStorageClass": {
+			get: func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+				if _, ok := obj.(*storagev1.StorageClass); ok {
+					return errBoom
+				}
+				return get(map[string]*corev1.PersistentVolumeClaim{"a": volumeClaim("1Gi")}, true)(ctx, key, obj)
+			},
+			tmpl:  tmpl,
+			names: []string{"a"},
+			want: want{
+				err: errors.Wrap(errBoom, errGetStorageClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated []string
+			kube := &test.MockClient{
+				MockGet: tc.get,
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					updated = append(updated, obj.(*corev1.PersistentVolumeClaim).GetName())
+					return nil
+				},
+			}
+			got, err := ExpandVolumeClaims(context.Background(), kube, namespace, tc.tmpl, tc.names)
+
+			if diff := cmp.Diff(tc.want.obs, got); diff != "" {
+				t.Errorf("ExpandVolumeClaims(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("ExpandVolumeClaims(...): -want updated, +got updated:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ExpandVolumeClaims(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
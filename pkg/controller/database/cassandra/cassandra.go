@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/cassandra.rook.io/v1alpha1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/database/cassandra"
+)
+
+// Error strings.
+const (
+	errNewCassandraClient     = "cannot create new Kubernetes client"
+	errNotCassandraCluster    = "managed resource is not a Cassandra cluster"
+	errGetCassandraCluster    = "cannot get Cassandra cluster in target Kubernetes cluster"
+	errCreateCassandraCluster = "cannot create Cassandra cluster in target Kubernetes cluster"
+	errUpdateCassandraCluster = "cannot update Cassandra cluster in target Kubernetes cluster"
+	errDeleteCassandraCluster = "cannot delete Cassandra cluster in target Kubernetes cluster"
+)
+
+// Setup creates a new CassandraCluster Controller and adds it to the Manager
+// with default RBAC. The Manager will set fields on the Controller and start it
+// when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CassandraClusterKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CassandraCluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CassandraClusterGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(rookv1alpha1.SchemeGroupVersion,
+		&rookv1alpha1.Cluster{},
+		&rookv1alpha1.ClusterList{},
+	)
+	metav1.AddToGroupVersion(scheme, rookv1alpha1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &external{client: cl}, errors.Wrap(err, errNewCassandraClient)
+}
+
+type external struct {
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha1.CassandraCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCassandraCluster)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.CassandraClusterParameters.Name,
+		Namespace: c.Spec.CassandraClusterParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.Cluster{}
+
+	err := e.client.Get(ctx, key, external)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCassandraCluster)
+	}
+
+	// Unlike the Cockroach and Yugabyte clusters the Rook Cassandra cluster
+	// reports the number of ready members of each rack, so we only consider
+	// it available once every rack is fully ready.
+	switch {
+	case cassandra.IsAvailable(c, external):
+		c.Status.SetConditions(xpv1.Available())
+	default:
+		c.Status.SetConditions(xpv1.Creating())
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: cassandra.ConnectionDetails(c),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha1.CassandraCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCassandraCluster)
+	}
+
+	c.Status.SetConditions(xpv1.Creating())
+
+	err := e.client.Create(ctx, cassandra.CrossToRook(c))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCassandraCluster)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha1.CassandraCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCassandraCluster)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.CassandraClusterParameters.Name,
+		Namespace: c.Spec.CassandraClusterParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.Cluster{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCassandraCluster)
+	}
+
+	if !cassandra.NeedsUpdate(c, external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	update := cassandra.CrossToRook(c)
+	update.ResourceVersion = external.ResourceVersion
+	err := e.client.Update(ctx, update)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCassandraCluster)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha1.CassandraCluster)
+	if !ok {
+		return errors.New(errNotCassandraCluster)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+
+	key := types.NamespacedName{
+		Name:      c.Spec.CassandraClusterParameters.Name,
+		Namespace: c.Spec.CassandraClusterParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.Cluster{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetCassandraCluster)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrap(err, errDeleteCassandraCluster)
+}
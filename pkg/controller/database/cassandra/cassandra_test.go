@@ -0,0 +1,568 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cassandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/cassandra.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+	uid       = types.UID("definitely-a-uuid")
+
+	connectionSecretName = "cool-connection-secret"
+
+	rackName = "cool-rack"
+)
+
+var errorBoom = errors.New("boom")
+var errorCassandraNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "cassandra.rook.io",
+		Resource: "Cluster"},
+	"boom")
+
+var connectionDetails = managed.ConnectionDetails{
+	xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-name-client.cool-namespace.svc"),
+	xpv1.ResourceCredentialsSecretPortKey:     []byte("9042"),
+}
+
+type cassandraStrange struct {
+	resource.Managed
+}
+
+type cassandraClusterModifier func(*v1alpha1.CassandraCluster)
+
+func withConditions(c ...xpv1.Condition) cassandraClusterModifier {
+	return func(i *v1alpha1.CassandraCluster) { i.Status.SetConditions(c...) }
+}
+
+func cassandraCluster(im ...cassandraClusterModifier) *v1alpha1.CassandraCluster {
+	i := &v1alpha1.CassandraCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.CassandraClusterSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			CassandraClusterParameters: v1alpha1.CassandraClusterParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Version:     "3.11.1",
+				Mode:        "cassandra",
+				Datacenter: v1alpha1.DatacenterSpec{
+					Name: "cool-dc",
+					Racks: []v1alpha1.RackSpec{{
+						Name:    rackName,
+						Members: 3,
+					}},
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookCassandraClusterModifier func(*rookv1alpha1.Cluster)
+
+func withMembers(m int32) rookCassandraClusterModifier {
+	return func(c *rookv1alpha1.Cluster) { c.Spec.Datacenter.Racks[0].Members = m }
+}
+
+func withReadyMembers(m int32) rookCassandraClusterModifier {
+	return func(c *rookv1alpha1.Cluster) {
+		c.Status.Racks = map[string]*rookv1alpha1.RackStatus{rackName: {Members: 3, ReadyMembers: m}}
+	}
+}
+
+func rookCassandraCluster(im ...rookCassandraClusterModifier) *rookv1alpha1.Cluster {
+	i := &rookv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: rookv1alpha1.ClusterSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Version:     "3.11.1",
+			Mode:        rookv1alpha1.ClusterModeCassandra,
+			Datacenter: rookv1alpha1.DatacenterSpec{
+				Name: "cool-dc",
+				Racks: []rookv1alpha1.RackSpec{{
+					Name:    rackName,
+					Members: 3,
+				}},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserveCassandra(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedClusterAvailable": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster(withReadyMembers(3))
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(
+					withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"ObservedClusterCreating": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster(withReadyMembers(1))
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(
+					withConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"ObservedClusterDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorCassandraNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg:          cassandraCluster(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToGetCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg:  cassandraCluster(),
+				err: errors.Wrap(errorBoom, errGetCassandraCluster),
+			},
+		},
+		"NotCassandraCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &cassandraStrange{},
+			},
+			want: want{
+				mg:  &cassandraStrange{},
+				err: errors.New(errNotCassandraCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateCassandra(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedCluster": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(withConditions(xpv1.Creating())),
+			},
+		},
+		"NotCassandraCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &cassandraStrange{},
+			},
+			want: want{
+				mg:  &cassandraStrange{},
+				err: errors.New(errNotCassandraCluster),
+			},
+		},
+		"FailedToCreateCluster": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg:  cassandraCluster(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateCassandraCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateCassandra(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"UpdatedCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster(withMembers(4))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster()
+					}
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(),
+			},
+		},
+		"NotCassandraCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &cassandraStrange{},
+			},
+			want: want{
+				mg:  &cassandraStrange{},
+				err: errors.New(errNotCassandraCluster),
+			},
+		},
+		"FailedToGetCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg:  cassandraCluster(),
+				err: errors.Wrap(errorBoom, errGetCassandraCluster),
+			},
+		},
+		"FailedToUpdateCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster(withMembers(4))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return errorBoom
+				},
+			}},
+
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg:  cassandraCluster(),
+				err: errors.Wrap(errorBoom, errUpdateCassandraCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteCassandra(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClusterAlreadyGone": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorCassandraNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg: cassandraCluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotCassandraCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &cassandraStrange{},
+			},
+			want: want{
+				mg:  &cassandraStrange{},
+				err: errors.New(errNotCassandraCluster),
+			},
+		},
+		"FailedToDeleteCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.Cluster) = *rookCassandraCluster()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				}},
+			},
+
+			args: args{
+				ctx: context.Background(),
+				mg:  cassandraCluster(),
+			},
+			want: want{
+				mg:  cassandraCluster(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteCassandraCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustersetting
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect                    = "cannot connect to referenced CockroachCluster"
+	errNotCockroachClusterSetting = "managed resource is not a Cockroach cluster setting"
+	errShowClusterSetting         = "cannot show cluster setting"
+	errSetClusterSetting          = "cannot set cluster setting"
+	errResetClusterSetting        = "cannot reset cluster setting"
+
+	errFmtInvalidName = "invalid cluster setting name %q"
+	errFmtNoValue     = "no value shown for cluster setting %q"
+	errFmtDrifted     = "cluster settings differ from their desired values: %s"
+)
+
+// Cluster setting names are dotted, lower case identifiers. They cannot be
+// quoted, so they are validated before they are used in a statement.
+var settingName = regexp.MustCompile(`^[a-z0-9_]+(\.[a-z0-9_]+)*$`)
+
+// Setup creates a new CockroachClusterSetting Controller and adds it to the
+// Manager with default RBAC. The Manager will set fields on the Controller and
+// start it when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CockroachClusterSettingKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CockroachClusterSetting{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CockroachClusterSettingGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	s, ok := mg.(*v1alpha1.CockroachClusterSetting)
+	if !ok {
+		return nil, errors.New(errNotCockroachClusterSetting)
+	}
+
+	conn, err := cockroach.GetConnection(ctx, c.client, s.Spec.CockroachClusterSettingParameters.CockroachClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn))}, nil
+}
+
+type external struct {
+	db pgwire.DB
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	s, ok := mg.(*v1alpha1.CockroachClusterSetting)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCockroachClusterSetting)
+	}
+
+	// Cluster settings always exist, so we consider them to exist until they
+	// have been reset by a delete.
+	if clients.IsRemoved(s) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	want := s.Spec.CockroachClusterSettingParameters.Settings
+	got := make(map[string]string, len(want))
+	drifted := []string{}
+	for _, name := range names(want) {
+		if !settingName.MatchString(name) {
+			return managed.ExternalObservation{}, errors.Errorf(errFmtInvalidName, name)
+		}
+		values, err := e.db.Strings(ctx, "SHOW CLUSTER SETTING "+name)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errShowClusterSetting)
+		}
+		if len(values) == 0 {
+			return managed.ExternalObservation{}, errors.Errorf(errFmtNoValue, name)
+		}
+		got[name] = values[0]
+		if values[0] != want[name] {
+			drifted = append(drifted, name)
+		}
+	}
+
+	s.Status.AtProvider.Settings = got
+
+	if len(drifted) > 0 {
+		s.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errFmtDrifted, strings.Join(drifted, ", "))))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	s.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create is never called in practice, because cluster settings always exist.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	s, ok := mg.(*v1alpha1.CockroachClusterSetting)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCockroachClusterSetting)
+	}
+
+	return managed.ExternalCreation{}, e.set(ctx, s.Spec.CockroachClusterSettingParameters.Settings)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	s, ok := mg.(*v1alpha1.CockroachClusterSetting)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCockroachClusterSetting)
+	}
+
+	return managed.ExternalUpdate{}, e.set(ctx, s.Spec.CockroachClusterSettingParameters.Settings)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	s, ok := mg.(*v1alpha1.CockroachClusterSetting)
+	if !ok {
+		return errors.New(errNotCockroachClusterSetting)
+	}
+
+	for _, name := range names(s.Spec.CockroachClusterSettingParameters.Settings) {
+		if !settingName.MatchString(name) {
+			return errors.Errorf(errFmtInvalidName, name)
+		}
+		if err := e.db.Exec(ctx, "RESET CLUSTER SETTING "+name); err != nil {
+			return errors.Wrap(err, errResetClusterSetting)
+		}
+	}
+
+	clients.SetRemoved(s)
+	return nil
+}
+
+// set sets the supplied cluster settings. Setting a cluster setting to its
+// current value is harmless, so every setting is set.
+func (e *external) set(ctx context.Context, settings map[string]string) error {
+	for _, name := range names(settings) {
+		if !settingName.MatchString(name) {
+			return errors.Errorf(errFmtInvalidName, name)
+		}
+		if err := e.db.Exec(ctx, "SET CLUSTER SETTING "+name+" = "+pgwire.QuoteLiteral(settings[name])); err != nil {
+			return errors.Wrap(err, errSetClusterSetting)
+		}
+	}
+	return nil
+}
+
+// names returns the names of the supplied settings in a stable order.
+func names(settings map[string]string) []string {
+	out := make([]string, 0, len(settings))
+	for n := range settings {
+		out = append(out, n)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustersetting
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+const (
+	name    = "cool-name"
+	cluster = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+type settingStrange struct {
+	resource.Managed
+}
+
+type cockroachClusterSettingModifier func(*v1alpha1.CockroachClusterSetting)
+
+func withConditions(c ...xpv1.Condition) cockroachClusterSettingModifier {
+	return func(i *v1alpha1.CockroachClusterSetting) { i.Status.SetConditions(c...) }
+}
+
+func withSettings(s map[string]string) cockroachClusterSettingModifier {
+	return func(i *v1alpha1.CockroachClusterSetting) { i.Spec.CockroachClusterSettingParameters.Settings = s }
+}
+
+func withObserved(s map[string]string) cockroachClusterSettingModifier {
+	return func(i *v1alpha1.CockroachClusterSetting) { i.Status.AtProvider.Settings = s }
+}
+
+func withDeletionTimestamp(t metav1.Time) cockroachClusterSettingModifier {
+	return func(i *v1alpha1.CockroachClusterSetting) { i.SetDeletionTimestamp(&t) }
+}
+
+func cockroachClusterSetting(im ...cockroachClusterSettingModifier) *v1alpha1.CockroachClusterSetting {
+	i := &v1alpha1.CockroachClusterSetting{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CockroachClusterSettingSpec{
+			CockroachClusterSettingParameters: v1alpha1.CockroachClusterSettingParameters{
+				Settings: map[string]string{
+					"kv.rangefeed.enabled":         "true",
+					"server.time_until_store_dead": "5m0s",
+				},
+				CockroachClusterReference: v1alpha1.CockroachClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// show returns a MockStrings function that returns the supplied values of
+// cluster settings.
+func show(values map[string]string) func(context.Context, string, ...interface{}) ([]string, error) {
+	return func(_ context.Context, query string, _ ...interface{}) ([]string, error) {
+		v, ok := values[strings.TrimPrefix(query, "SHOW CLUSTER SETTING ")]
+		if !ok {
+			return nil, nil
+		}
+		return []string{v}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	now := metav1.Now()
+	current := map[string]string{
+		"kv.rangefeed.enabled":         "true",
+		"server.time_until_store_dead": "5m0s",
+	}
+	drifted := map[string]string{
+		"kv.rangefeed.enabled":         "false",
+		"server.time_until_store_dead": "5m0s",
+	}
+
+	cases := map[string]struct {
+		db   pgwire.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedSettingsUpToDate": {
+			db: &pgwire.MockDB{MockStrings: show(current)},
+			mg: cockroachClusterSetting(),
+			want: want{
+				mg:          cockroachClusterSetting(withObserved(current), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObservedSettingsDrifted": {
+			db: &pgwire.MockDB{MockStrings: show(drifted)},
+			mg: cockroachClusterSetting(),
+			want: want{
+				mg: cockroachClusterSetting(withObserved(drifted), withConditions(
+					xpv1.Unavailable().WithMessage("cluster settings differ from their desired values: kv.rangefeed.enabled"),
+				)),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ObservedSettingsReset": {
+			mg: cockroachClusterSetting(withDeletionTimestamp(now), withConditions(xpv1.Deleting())),
+			want: want{
+				mg:          cockroachClusterSetting(withDeletionTimestamp(now), withConditions(xpv1.Deleting())),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"InvalidSettingName": {
+			mg: cockroachClusterSetting(withSettings(map[string]string{"kv; DROP DATABASE": "true"})),
+			want: want{
+				mg:  cockroachClusterSetting(withSettings(map[string]string{"kv; DROP DATABASE": "true"})),
+				err: errors.Errorf(errFmtInvalidName, "kv; DROP DATABASE"),
+			},
+		},
+		"NoValueShown": {
+			db: &pgwire.MockDB{MockStrings: show(map[string]string{})},
+			mg: cockroachClusterSetting(withSettings(map[string]string{"cool.setting": "true"})),
+			want: want{
+				mg:  cockroachClusterSetting(withSettings(map[string]string{"cool.setting": "true"})),
+				err: errors.Errorf(errFmtNoValue, "cool.setting"),
+			},
+		},
+		"FailedToShowSetting": {
+			db: &pgwire.MockDB{
+				MockStrings: func(_ context.Context, _ string, _ ...interface{}) ([]string, error) {
+					return nil, errorBoom
+				},
+			},
+			mg: cockroachClusterSetting(),
+			want: want{
+				mg:  cockroachClusterSetting(),
+				err: errors.Wrap(errorBoom, errShowClusterSetting),
+			},
+		},
+		"NotCockroachClusterSetting": {
+			mg: &settingStrange{},
+			want: want{
+				mg:  &settingStrange{},
+				err: errors.New(errNotCockroachClusterSetting),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		stmts []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"SetSettings": {
+			mg: cockroachClusterSetting(),
+			want: want{
+				stmts: []string{
+					"SET CLUSTER SETTING kv.rangefeed.enabled = 'true'",
+					"SET CLUSTER SETTING server.time_until_store_dead = '5m0s'",
+				},
+			},
+		},
+		"InvalidSettingName": {
+			mg: cockroachClusterSetting(withSettings(map[string]string{"kv; DROP DATABASE": "true"})),
+			want: want{
+				err: errors.Errorf(errFmtInvalidName, "kv; DROP DATABASE"),
+			},
+		},
+		"FailedToSetSetting": {
+			err: errorBoom,
+			mg:  cockroachClusterSetting(),
+			want: want{
+				stmts: []string{"SET CLUSTER SETTING kv.rangefeed.enabled = 'true'"},
+				err:   errors.Wrap(errorBoom, errSetClusterSetting),
+			},
+		},
+		"NotCockroachClusterSetting": {
+			mg: &settingStrange{},
+			want: want{
+				err: errors.New(errNotCockroachClusterSetting),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stmts []string
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmts = append(stmts, query)
+					return tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmts, stmts); diff != "" {
+				t.Errorf("e.Update(): -want statements, +got statements:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg    resource.Managed
+		stmts []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"ResetSettings": {
+			mg: cockroachClusterSetting(),
+			want: want{
+				mg: cockroachClusterSetting(withConditions(xpv1.Deleting())),
+				stmts: []string{
+					"RESET CLUSTER SETTING kv.rangefeed.enabled",
+					"RESET CLUSTER SETTING server.time_until_store_dead",
+				},
+			},
+		},
+		"FailedToResetSetting": {
+			err: errorBoom,
+			mg:  cockroachClusterSetting(),
+			want: want{
+				mg:    cockroachClusterSetting(),
+				stmts: []string{"RESET CLUSTER SETTING kv.rangefeed.enabled"},
+				err:   errors.Wrap(errorBoom, errResetClusterSetting),
+			},
+		},
+		"NotCockroachClusterSetting": {
+			mg: &settingStrange{},
+			want: want{
+				mg:  &settingStrange{},
+				err: errors.New(errNotCockroachClusterSetting),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stmts []string
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmts = append(stmts, query)
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmts, stmts); diff != "" {
+				t.Errorf("e.Delete(): -want statements, +got statements:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
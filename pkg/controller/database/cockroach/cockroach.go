@@ -19,20 +19,22 @@ package cockroach
 import (
 	"context"
 	"fmt"
-
-	"github.com/crossplane/provider-rook/pkg/clients"
-
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/cockroachdb.rook.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	rookv1alpha1 "github.com/rook/rook/pkg/apis/cockroachdb.rook.io/v1alpha1"
-
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -40,7 +42,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
 	"github.com/crossplane/provider-rook/pkg/clients/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
 )
 
 // Error strings.
@@ -51,6 +56,26 @@ const (
 	errCreateCockroachCluster = "cannot create Cockroach cluster in target Kubernetes cluster"
 	errUpdateCockroachCluster = "cannot update Cockroach cluster in target Kubernetes cluster"
 	errDeleteCockroachCluster = "cannot delete Cockroach cluster in target Kubernetes cluster"
+	errShowRestoreJob         = "cannot show Cockroach restore job"
+	errRestoreCockroach       = "cannot restore Cockroach cluster from backup"
+	errExpandVolumeClaims     = "cannot expand Cockroach volume claims"
+
+	errFmtRestoreFailed = "restore job %s: %s"
+)
+
+// restoreTimeout bounds a restore that runs in the background. CockroachDB
+// v2.0 runs RESTORE until its job finishes, which may take hours.
+const restoreTimeout = 24 * time.Hour
+
+// restoreJobWait is how long an update waits for a restore job it started to
+// be created, so that the job is recorded before the update returns.
+const restoreJobWait = 10 * time.Second
+
+// Statuses of CockroachDB jobs.
+const (
+	jobSucceeded = "succeeded"
+	jobFailed    = "failed"
+	jobCanceled  = "canceled"
 )
 
 // Setup creates a new CockroachCluster Controller and adds it to the Manager
@@ -63,13 +88,15 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.CockroachCluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CockroachClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New, restorer: newRestorer()}),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
 type connecter struct {
-	client client.Client
+	client   client.Client
+	newDB    func(dsn string) pgwire.DB
+	restorer *restorer
 }
 
 func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -80,12 +107,28 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	)
 	metav1.AddToGroupVersion(scheme, rookv1alpha1.SchemeGroupVersion)
 
+	scheme.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.PersistentVolumeClaim{})
+	metav1.AddToGroupVersion(scheme, corev1.SchemeGroupVersion)
+	scheme.AddKnownTypes(storagev1.SchemeGroupVersion, &storagev1.StorageClass{})
+	metav1.AddToGroupVersion(scheme, storagev1.SchemeGroupVersion)
+
 	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
-	return &external{client: cl}, errors.Wrap(err, errNewCockroachClient)
+	e := &external{client: cl, local: c.client, restorer: c.restorer, jobWait: restoreJobWait}
+
+	// Only clusters that are restored from a backup are connected to via SQL.
+	if cc, ok := mg.(*v1alpha1.CockroachCluster); ok && cc.Spec.CockroachClusterParameters.RestoreFrom != nil {
+		e.db = c.newDB(pgwire.DSN(cockroach.Connection(cc)))
+	}
+
+	return e, errors.Wrap(err, errNewCockroachClient)
 }
 
 type external struct {
-	client client.Client
+	client   client.Client
+	local    client.Reader
+	db       pgwire.DB
+	restorer *restorer
+	jobWait  time.Duration
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -109,18 +152,45 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetCockroachCluster)
 	}
 
-	// If we are able to get the resource Cluster instance, we will consider
-	// it available. If a status is added to the Cluster CRD in the future we
-	// should check it to set conditions.
-	c.Status.SetConditions(xpv1.Available())
-
 	o := managed.ExternalObservation{
 		ResourceExists:    true,
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: cockroach.ConnectionDetails(c),
 	}
 
-	return o, nil
+	// If we are able to get the resource Cluster instance, we will consider
+	// it available unless it is being restored from a backup. If a status is
+	// added to the Cluster CRD in the future we should check it to set
+	// conditions.
+	r := c.Status.AtProvider.Restore
+	if c.Spec.CockroachClusterParameters.RestoreFrom == nil || (r != nil && r.Status == jobSucceeded) {
+		c.Status.SetConditions(xpv1.Available())
+		return o, nil
+	}
+
+	// The restore job cannot be shown until the cluster accepts SQL
+	// connections. Until then the cluster is still being created.
+	job, err := e.restoreJob(ctx, r)
+	if pgwire.IsUnreachable(err) {
+		c.Status.SetConditions(xpv1.Creating())
+		return o, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errShowRestoreJob)
+	}
+	c.Status.AtProvider.Restore = job
+
+	switch {
+	case job == nil:
+		c.Status.SetConditions(xpv1.Creating())
+	case job.Status == jobSucceeded:
+		c.Status.SetConditions(xpv1.Available())
+	case job.Status == jobFailed || job.Status == jobCanceled:
+		c.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errFmtRestoreFailed, job.Status, job.Error)))
+	default:
+		c.Status.SetConditions(xpv1.Creating())
+	}
 
+	return o, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -152,14 +222,51 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCockroachCluster)
 	}
 
-	if !cockroach.NeedsUpdate(c, external) {
+	// A volume claim that cannot be expanded, for example because its
+	// requested storage shrank, blocks every other update to the cluster
+	// until the request is reverted.
+	claims, err := e.expandVolumeClaims(ctx, c)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errExpandVolumeClaims)
+	}
+	c.Status.AtProvider.VolumeClaims = claims
+
+	if cockroach.NeedsUpdate(c, external) {
+		update := cockroach.CrossToRook(c)
+		update.ResourceVersion = external.ResourceVersion
+		if err := e.client.Update(ctx, update); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCockroachCluster)
+		}
+	}
+
+	// A cluster is restored only once. Observe tracks the restore job once
+	// it has been recorded.
+	if c.Spec.CockroachClusterParameters.RestoreFrom == nil || c.Status.AtProvider.Restore != nil {
 		return managed.ExternalUpdate{}, nil
 	}
 
-	update := cockroach.CrossToRook(c)
-	update.ResourceVersion = external.ResourceVersion
-	err := e.client.Update(ctx, update)
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCockroachCluster)
+	// The cluster cannot be restored until it accepts SQL connections. A job
+	// may exist that a previous update started but did not record.
+	job, err := e.restoreJob(ctx, nil)
+	if pgwire.IsUnreachable(err) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errShowRestoreJob)
+	}
+	if job == nil {
+		src := *c.Spec.CockroachClusterParameters.RestoreFrom
+		if err := e.restorer.Start(c.GetUID(), func(ctx context.Context) error { return e.restore(ctx, src) }); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		job, err = e.awaitRestoreJob(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errShowRestoreJob)
+		}
+	}
+
+	c.Status.AtProvider.Restore = job
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -187,3 +294,128 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	err := e.client.Delete(ctx, external)
 	return errors.Wrap(err, errDeleteCockroachCluster)
 }
+
+// expandVolumeClaims expands the existing volume claims of the nodes of the
+// supplied cluster to the storage requested by their templates.
+func (e *external) expandVolumeClaims(ctx context.Context, c *v1alpha1.CockroachCluster) ([]corev1alpha1.VolumeClaimObservation, error) {
+	params := c.Spec.CockroachClusterParameters
+	var claims []corev1alpha1.VolumeClaimObservation
+	for _, t := range params.Storage.VolumeClaimTemplates {
+		o, err := clients.ExpandVolumeClaims(ctx, e.client, params.Namespace, t, cockroach.VolumeClaimNames(c, t))
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, o...)
+	}
+	return claims, nil
+}
+
+// restoreJob returns the supplied restore job, or the most recent restore job
+// if none is supplied. It returns nil if there is no such job.
+func (e *external) restoreJob(ctx context.Context, r *v1alpha1.CockroachRestoreObservation) (*v1alpha1.CockroachRestoreObservation, error) {
+	query := "SELECT id, status, COALESCE(fraction_completed, 0), COALESCE(error, '') FROM crdb_internal.jobs WHERE type = 'RESTORE' ORDER BY created DESC LIMIT 1"
+	args := []interface{}{}
+	if r != nil {
+		query = "SELECT id, status, COALESCE(fraction_completed, 0), COALESCE(error, '') FROM crdb_internal.jobs WHERE id = $1"
+		args = append(args, r.JobID)
+	}
+
+	job := &v1alpha1.CockroachRestoreObservation{}
+	var fraction float64
+	err := e.db.Scan(ctx, query, []interface{}{&job.JobID, &job.Status, &fraction, &job.Error}, args...)
+	if pgwire.IsNoRows(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	job.FractionCompleted = strconv.FormatFloat(fraction, 'f', 2, 64)
+	return job, nil
+}
+
+// awaitRestoreJob returns the most recent restore job once it has been
+// created. It returns nil if no job is created within the job wait.
+func (e *external) awaitRestoreJob(ctx context.Context) (*v1alpha1.CockroachRestoreObservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.jobWait)
+	defer cancel()
+
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+
+	for {
+		job, err := e.restoreJob(ctx, nil)
+		if ctx.Err() != nil {
+			return nil, nil
+		}
+		if job != nil || err != nil {
+			return job, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-t.C:
+		}
+	}
+}
+
+// restore restores the databases of the supplied backup. CockroachDB v2.0
+// runs RESTORE until its job finishes, so restores are run by a restorer.
+func (e *external) restore(ctx context.Context, r v1alpha1.CockroachRestoreSource) error {
+	uri, err := cockroach.RestoreURI(ctx, e.local, r)
+	if err != nil {
+		return errors.Wrap(err, errRestoreCockroach)
+	}
+
+	dbs := make([]string, len(r.Databases))
+	for i, db := range r.Databases {
+		dbs[i] = pgwire.QuoteIdentifier(db)
+	}
+
+	err = e.db.Exec(ctx, "RESTORE DATABASE "+strings.Join(dbs, ", ")+" FROM "+pgwire.QuoteLiteral(uri))
+	return errors.Wrap(err, errRestoreCockroach)
+}
+
+// A restorer runs restores in the background. CockroachDB v2.0 runs RESTORE
+// until its job finishes and ignores requests to cancel the statement, so a
+// restore must not hold a reconcile open.
+type restorer struct {
+	mu      sync.Mutex
+	running map[types.UID]bool
+	failed  map[types.UID]error
+}
+
+func newRestorer() *restorer {
+	return &restorer{running: map[types.UID]bool{}, failed: map[types.UID]error{}}
+}
+
+// Start runs the supplied restore of the cluster with the supplied UID in the
+// background, unless one is already running. It returns the error of the
+// previous restore of the cluster if that failed, in which case the restore
+// is started again by the next call.
+func (r *restorer) Start(uid types.UID, restore func(ctx context.Context) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err, ok := r.failed[uid]; ok {
+		delete(r.failed, uid)
+		return err
+	}
+	if r.running[uid] {
+		return nil
+	}
+	r.running[uid] = true
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+		defer cancel()
+		err := restore(ctx)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.running, uid)
+		if err != nil {
+			r.failed[uid] = err
+		}
+	}()
+	return nil
+}
@@ -18,14 +18,19 @@ package cockroach
 
 import (
 	"context"
+	"database/sql"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	rookv1alpha1 "github.com/rook/rook/pkg/apis/cockroachdb.rook.io/v1alpha1"
 	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -39,6 +44,7 @@ import (
 
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
 	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
 )
 
 const (
@@ -50,6 +56,7 @@ const (
 )
 
 var errorBoom = errors.New("boom")
+var errUnreachable = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
 var errorCockroachNotFound = kerrors.NewNotFound(
 	schema.GroupResource{
 		Group:    "cockroachdb.rook.io",
@@ -66,6 +73,26 @@ func withConditions(c ...xpv1.Condition) cockroachClusterModifier {
 	return func(i *v1alpha1.CockroachCluster) { i.Status.SetConditions(c...) }
 }
 
+func withRestoreFrom(r *v1alpha1.CockroachRestoreSource) cockroachClusterModifier {
+	return func(i *v1alpha1.CockroachCluster) { i.Spec.CockroachClusterParameters.RestoreFrom = r }
+}
+
+func withRestore(r *v1alpha1.CockroachRestoreObservation) cockroachClusterModifier {
+	return func(i *v1alpha1.CockroachCluster) { i.Status.AtProvider.Restore = r }
+}
+
+func withStorage(storage string) cockroachClusterModifier {
+	return func(i *v1alpha1.CockroachCluster) {
+		i.Spec.CockroachClusterParameters.Storage.VolumeClaimTemplates[0].Spec.Resources.Requests = corev1.ResourceList{
+			corev1.ResourceStorage: kresource.MustParse(storage),
+		}
+	}
+}
+
+func withVolumeClaims(o ...corev1alpha1.VolumeClaimObservation) cockroachClusterModifier {
+	return func(i *v1alpha1.CockroachCluster) { i.Status.AtProvider.VolumeClaims = o }
+}
+
 func cockroachCluster(im ...cockroachClusterModifier) *v1alpha1.CockroachCluster {
 	i := &v1alpha1.CockroachCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -170,10 +197,35 @@ func rookCockroachCluster(im ...rookCockroachClusterModifier) *rookv1alpha1.Clus
 	return i
 }
 
+// scanJob returns a MockScan function that scans the supplied restore job.
+func scanJob(job *v1alpha1.CockroachRestoreObservation, fraction float64) func(context.Context, string, []interface{}, ...interface{}) error {
+	return func(_ context.Context, _ string, dest []interface{}, _ ...interface{}) error {
+		if job == nil {
+			return sql.ErrNoRows
+		}
+		*dest[0].(*int64) = job.JobID
+		*dest[1].(*string) = job.Status
+		*dest[2].(*float64) = fraction
+		*dest[3].(*string) = job.Error
+		return nil
+	}
+}
+
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connecter{}
 
 func TestObserveCockroach(t *testing.T) {
+	getCluster := &test.MockClient{MockGet: test.NewMockGetFn(nil)}
+	restoreFrom := &v1alpha1.CockroachRestoreSource{Databases: []string{"cool-db"}, URI: "nodelocal:///cool"}
+	connectionDetails := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("cockroachdb-public.cool-namespace.svc"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("26257"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("root"),
+	}
+	running := &v1alpha1.CockroachRestoreObservation{JobID: 42, Status: "running", FractionCompleted: "0.50"}
+	succeeded := &v1alpha1.CockroachRestoreObservation{JobID: 42, Status: "succeeded", FractionCompleted: "1.00"}
+	failed := &v1alpha1.CockroachRestoreObservation{JobID: 42, Status: "failed", FractionCompleted: "0.00", Error: "boom"}
+
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
@@ -211,11 +263,97 @@ func TestObserveCockroach(t *testing.T) {
 				mg: cockroachCluster(
 					withConditions(xpv1.Available())),
 				observation: managed.ExternalObservation{
-					ResourceExists:    true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("cockroachdb-public.cool-namespace.svc"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("26257"),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte("root"),
+					},
 				},
 			},
 		},
+		"ObservedRestoreNotStarted": {
+			client: &external{client: getCluster, db: &pgwire.MockDB{MockScan: scanJob(nil, 0)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg:          cockroachCluster(withRestoreFrom(restoreFrom), withConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails},
+			},
+		},
+		"ObservedRestoreRunning": {
+			client: &external{client: getCluster, db: &pgwire.MockDB{MockScan: scanJob(running, 0.5)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg:          cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running), withConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails},
+			},
+		},
+		"ObservedRestoreSucceeded": {
+			client: &external{client: getCluster, db: &pgwire.MockDB{MockScan: scanJob(succeeded, 1)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running)),
+			},
+			want: want{
+				mg:          cockroachCluster(withRestoreFrom(restoreFrom), withRestore(succeeded), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails},
+			},
+		},
+		"ObservedRestoreFailed": {
+			client: &external{client: getCluster, db: &pgwire.MockDB{MockScan: scanJob(failed, 0)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running)),
+			},
+			want: want{
+				mg: cockroachCluster(withRestoreFrom(restoreFrom), withRestore(failed),
+					withConditions(xpv1.Unavailable().WithMessage("restore job failed: boom"))),
+				observation: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails},
+			},
+		},
+		"ObservedRestoredCluster": {
+			client: &external{client: getCluster},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom), withRestore(succeeded)),
+			},
+			want: want{
+				mg:          cockroachCluster(withRestoreFrom(restoreFrom), withRestore(succeeded), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails},
+			},
+		},
+		"FailedToShowRestoreJob": {
+			client: &external{client: getCluster, db: &pgwire.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error { return errorBoom },
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+				err: errors.Wrap(errorBoom, errShowRestoreJob),
+			},
+		},
+		"ObservedRestoringClusterNotReachable": {
+			client: &external{client: getCluster, db: &pgwire.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error { return errUnreachable },
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg:          cockroachCluster(withRestoreFrom(restoreFrom), withConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{ResourceExists: true, ConnectionDetails: connectionDetails},
+			},
+		},
 		"ObservedClusterDoesNotExist": {
 			client: &external{client: &test.MockClient{
 				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
@@ -341,6 +479,35 @@ func TestCreateCockroach(t *testing.T) {
 }
 
 func TestUpdateCockroach(t *testing.T) {
+	restoreFrom := &v1alpha1.CockroachRestoreSource{Databases: []string{"cool-db", "cooler-db"}, URI: "nodelocal:///cool"}
+	running := &v1alpha1.CockroachRestoreObservation{JobID: 42, Status: "running", FractionCompleted: "0.50"}
+	started, finished := make(chan struct{}), make(chan struct{})
+	defer close(finished)
+	getCluster := func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		*obj.(*rookv1alpha1.Cluster) = *rookCockroachCluster()
+		return nil
+	}
+	// getVolumes returns a MockGet function that gets the cluster, volume
+	// claims that request the supplied storage, and a storage class that
+	// allows volume expansion.
+	getVolumes := func(storage string) func(context.Context, client.ObjectKey, runtime.Object) error {
+		return func(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+			switch o := obj.(type) {
+			case *corev1.PersistentVolumeClaim:
+				sc := "cool-class"
+				o.SetName(key.Name)
+				o.Spec.StorageClassName = &sc
+				o.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: kresource.MustParse(storage)}
+			case *storagev1.StorageClass:
+				expandable := true
+				o.AllowVolumeExpansion = &expandable
+			default:
+				return getCluster(ctx, key, obj)
+			}
+			return nil
+		}
+	}
+
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
@@ -393,6 +560,125 @@ func TestUpdateCockroach(t *testing.T) {
 				mg: cockroachCluster(),
 			},
 		},
+		"ExpandedVolumeClaims": {
+			client: &external{client: &test.MockClient{MockGet: getVolumes("1Gi"), MockUpdate: test.NewMockUpdateFn(nil)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withStorage("2Gi")),
+			},
+			want: want{
+				mg: cockroachCluster(withStorage("2Gi"), withVolumeClaims(
+					corev1alpha1.VolumeClaimObservation{Name: "rook-cockroachdb-test-rook-cockroachdb-0", Requested: "2Gi"},
+					corev1alpha1.VolumeClaimObservation{Name: "rook-cockroachdb-test-rook-cockroachdb-1", Requested: "2Gi"},
+					corev1alpha1.VolumeClaimObservation{Name: "rook-cockroachdb-test-rook-cockroachdb-2", Requested: "2Gi"},
+				)),
+			},
+		},
+		"RefusedVolumeClaimShrink": {
+			client: &external{client: &test.MockClient{MockGet: getVolumes("4Gi")}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withStorage("2Gi")),
+			},
+			want: want{
+				mg:  cockroachCluster(withStorage("2Gi")),
+				err: errors.Wrap(errors.New("cannot shrink volume claim rook-cockroachdb-test-rook-cockroachdb-0 from 4Gi to 2Gi"), errExpandVolumeClaims),
+			},
+		},
+		"StartedRestore": {
+			// RESTORE does not return until its job finishes, which
+			// outlives the update.
+			client: &external{
+				client:   &test.MockClient{MockGet: getCluster},
+				restorer: newRestorer(),
+				jobWait:  time.Minute,
+				db: &pgwire.MockDB{
+					MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+						if query != "RESTORE DATABASE \"cool-db\", \"cooler-db\" FROM 'nodelocal:///cool'" {
+							return errors.Errorf("unexpected query %q", query)
+						}
+						close(started)
+						<-finished
+						return nil
+					},
+					MockScan: func(ctx context.Context, query string, dest []interface{}, args ...interface{}) error {
+						select {
+						case <-started:
+							return scanJob(running, 0.5)(ctx, query, dest, args...)
+						default:
+							return sql.ErrNoRows
+						}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg: cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running)),
+			},
+		},
+		"RecordedRestoreJob": {
+			client: &external{client: &test.MockClient{MockGet: getCluster}, db: &pgwire.MockDB{MockScan: scanJob(running, 0.5)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg: cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running)),
+			},
+		},
+		"RestoreAlreadyStarted": {
+			client: &external{client: &test.MockClient{MockGet: getCluster}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running)),
+			},
+			want: want{
+				mg: cockroachCluster(withRestoreFrom(restoreFrom), withRestore(running)),
+			},
+		},
+		"ClusterNotReachable": {
+			client: &external{client: &test.MockClient{MockGet: getCluster}, db: &pgwire.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error { return errUnreachable },
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg: cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+		},
+		"FailedToShowRestoreJob": {
+			client: &external{client: &test.MockClient{MockGet: getCluster}, db: &pgwire.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error { return errorBoom },
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+				err: errors.Wrap(errorBoom, errShowRestoreJob),
+			},
+		},
+		"FailedToRestore": {
+			client: &external{
+				client:   &test.MockClient{MockGet: getCluster},
+				restorer: &restorer{running: map[types.UID]bool{}, failed: map[types.UID]error{uid: errors.Wrap(errorBoom, errRestoreCockroach)}},
+				db:       &pgwire.MockDB{MockScan: scanJob(nil, 0)},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+			},
+			want: want{
+				mg:  cockroachCluster(withRestoreFrom(restoreFrom)),
+				err: errors.Wrap(errorBoom, errRestoreCockroach),
+			},
+		},
 		"NotCockroachCluster": {
 			client: &external{},
 			args: args{
@@ -546,3 +832,44 @@ func TestDeleteCockroach(t *testing.T) {
 		})
 	}
 }
+
+func TestRestorer(t *testing.T) {
+	r := newRestorer()
+	release := make(chan struct{})
+	calls := make(chan struct{}, 1)
+	restore := func(_ context.Context) error {
+		calls <- struct{}{}
+		<-release
+		return errorBoom
+	}
+
+	if err := r.Start(uid, restore); err != nil {
+		t.Fatalf("r.Start(...): %s", err)
+	}
+	<-calls
+
+	// A running restore is not started again.
+	if err := r.Start(uid, restore); err != nil {
+		t.Fatalf("r.Start(...): %s", err)
+	}
+
+	close(release)
+	for {
+		r.mu.Lock()
+		done := !r.running[uid]
+		r.mu.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// A failed restore is reported, then started again.
+	if diff := cmp.Diff(errorBoom, r.Start(uid, restore), test.EquateErrors()); diff != "" {
+		t.Errorf("r.Start(...): -want error, +got error:\n%s", diff)
+	}
+	if err := r.Start(uid, restore); err != nil {
+		t.Fatalf("r.Start(...): %s", err)
+	}
+	<-calls
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect                 = "cannot connect to referenced CockroachCluster"
+	errNotCockroachDatabase    = "managed resource is not a Cockroach database"
+	errSelectCockroachDatabase = "cannot select Cockroach database"
+	errCreateCockroachDatabase = "cannot create Cockroach database"
+	errDropCockroachDatabase   = "cannot drop Cockroach database"
+)
+
+// Setup creates a new CockroachDatabase Controller and adds it to the Manager
+// with default RBAC. The Manager will set fields on the Controller and start it
+// when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CockroachDatabaseKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CockroachDatabase{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CockroachDatabaseGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	d, ok := mg.(*v1alpha1.CockroachDatabase)
+	if !ok {
+		return nil, errors.New(errNotCockroachDatabase)
+	}
+
+	conn, err := cockroach.GetConnection(ctx, c.client, d.Spec.CockroachDatabaseParameters.CockroachClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn))}, nil
+}
+
+type external struct {
+	db pgwire.DB
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	d, ok := mg.(*v1alpha1.CockroachDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCockroachDatabase)
+	}
+
+	exists, err := e.db.Exists(ctx, "SELECT 1 FROM pg_database WHERE datname = $1", d.Spec.CockroachDatabaseParameters.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectCockroachDatabase)
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d.Status.SetConditions(xpv1.Available())
+
+	// A database has no mutable parameters.
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	d, ok := mg.(*v1alpha1.CockroachDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCockroachDatabase)
+	}
+
+	d.Status.SetConditions(xpv1.Creating())
+
+	err := e.db.Exec(ctx, "CREATE DATABASE "+pgwire.QuoteIdentifier(d.Spec.CockroachDatabaseParameters.Name))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCockroachDatabase)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	d, ok := mg.(*v1alpha1.CockroachDatabase)
+	if !ok {
+		return errors.New(errNotCockroachDatabase)
+	}
+
+	d.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, "DROP DATABASE IF EXISTS "+pgwire.QuoteIdentifier(d.Spec.CockroachDatabaseParameters.Name))
+	return errors.Wrap(err, errDropCockroachDatabase)
+}
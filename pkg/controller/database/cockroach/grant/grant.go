@@ -0,0 +1,197 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect                 = "cannot connect to referenced CockroachCluster"
+	errNotCockroachGrant       = "managed resource is not a Cockroach grant"
+	errSelectCockroachDatabase = "cannot select Cockroach database"
+	errShowCockroachGrants     = "cannot show Cockroach grants"
+	errCreateCockroachGrant    = "cannot grant Cockroach privileges"
+	errUpdateCockroachGrant    = "cannot update Cockroach privileges"
+	errRevokeCockroachGrant    = "cannot revoke Cockroach privileges"
+)
+
+// Setup creates a new CockroachGrant Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CockroachGrantKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CockroachGrant{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CockroachGrantGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	g, ok := mg.(*v1alpha1.CockroachGrant)
+	if !ok {
+		return nil, errors.New(errNotCockroachGrant)
+	}
+
+	conn, err := cockroach.GetConnection(ctx, c.client, g.Spec.CockroachGrantParameters.CockroachClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn))}, nil
+}
+
+type external struct {
+	db pgwire.DB
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	g, ok := mg.(*v1alpha1.CockroachGrant)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCockroachGrant)
+	}
+
+	params := g.Spec.CockroachGrantParameters
+
+	// Privileges cannot be shown for a database that does not exist, and do
+	// not outlive the database on which they were granted.
+	exists, err := e.db.Exists(ctx, "SELECT 1 FROM pg_database WHERE datname = $1", params.Database)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectCockroachDatabase)
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Rook v1.1 deploys CockroachDB v2.0, which names the columns of SHOW
+	// statements in title case.
+	query := "SELECT DISTINCT \"Privileges\" FROM [SHOW GRANTS ON DATABASE " + pgwire.QuoteIdentifier(params.Database) + "] WHERE \"User\" = $1"
+	privileges, err := e.db.Strings(ctx, query, params.User)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errShowCockroachGrants)
+	}
+	if len(privileges) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	g.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: privilegesEqual(params.Privileges, privileges),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	g, ok := mg.(*v1alpha1.CockroachGrant)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCockroachGrant)
+	}
+
+	g.Status.SetConditions(xpv1.Creating())
+
+	err := e.db.Exec(ctx, grant(g.Spec.CockroachGrantParameters))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCockroachGrant)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	g, ok := mg.(*v1alpha1.CockroachGrant)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCockroachGrant)
+	}
+
+	// CockroachDB v2.0 does not run the statements of a single query in one
+	// transaction, so an explicit transaction ensures the user is never left
+	// without privileges if the grant fails. The transaction is rolled back
+	// when the connection is closed.
+	params := g.Spec.CockroachGrantParameters
+	err := e.db.Exec(ctx, "BEGIN; "+revoke(params)+"; "+grant(params)+"; COMMIT")
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCockroachGrant)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	g, ok := mg.(*v1alpha1.CockroachGrant)
+	if !ok {
+		return errors.New(errNotCockroachGrant)
+	}
+
+	g.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, revoke(g.Spec.CockroachGrantParameters))
+	return errors.Wrap(err, errRevokeCockroachGrant)
+}
+
+func grant(p v1alpha1.CockroachGrantParameters) string {
+	privileges := make([]string, len(p.Privileges))
+	for i, pr := range p.Privileges {
+		privileges[i] = string(pr)
+	}
+	return fmt.Sprintf("GRANT %s ON DATABASE %s TO %s",
+		strings.Join(privileges, ", "), pgwire.QuoteIdentifier(p.Database), pgwire.QuoteIdentifier(p.User))
+}
+
+func revoke(p v1alpha1.CockroachGrantParameters) string {
+	return fmt.Sprintf("REVOKE ALL ON DATABASE %s FROM %s", pgwire.QuoteIdentifier(p.Database), pgwire.QuoteIdentifier(p.User))
+}
+
+// privilegesEqual returns true if the supplied desired and observed privileges
+// are the same, ignoring order and duplicates.
+func privilegesEqual(want []v1alpha1.CockroachPrivilege, got []string) bool {
+	w := map[string]bool{}
+	for _, p := range want {
+		w[string(p)] = true
+	}
+	g := map[string]bool{}
+	for _, p := range got {
+		g[strings.ToUpper(p)] = true
+	}
+	if len(w) != len(g) {
+		return false
+	}
+	for p := range w {
+		if !g[p] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+const (
+	name     = "cool-name"
+	database = "cool-database"
+	user     = "cool-user"
+	cluster  = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+type grantStrange struct {
+	resource.Managed
+}
+
+type cockroachGrantModifier func(*v1alpha1.CockroachGrant)
+
+func withConditions(c ...xpv1.Condition) cockroachGrantModifier {
+	return func(i *v1alpha1.CockroachGrant) { i.Status.SetConditions(c...) }
+}
+
+func cockroachGrant(im ...cockroachGrantModifier) *v1alpha1.CockroachGrant {
+	i := &v1alpha1.CockroachGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CockroachGrantSpec{
+			CockroachGrantParameters: v1alpha1.CockroachGrantParameters{
+				Privileges:                []v1alpha1.CockroachPrivilege{"SELECT", "INSERT"},
+				Database:                  database,
+				User:                      user,
+				CockroachClusterReference: v1alpha1.CockroachClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	exists := func(_ context.Context, _ string, _ ...interface{}) (bool, error) { return true, nil }
+
+	// showGrants returns the supplied privileges if it is asked for the
+	// privileges of the user on the database, using the title case column
+	// names of CockroachDB v2.0.
+	showGrants := func(privileges ...string) func(context.Context, string, ...interface{}) ([]string, error) {
+		return func(_ context.Context, query string, args ...interface{}) ([]string, error) {
+			want := `SELECT DISTINCT "Privileges" FROM [SHOW GRANTS ON DATABASE "cool-database"] WHERE "User" = $1`
+			if diff := cmp.Diff(want, query); diff != "" {
+				return nil, errors.Errorf("-want query, +got query:\n%s", diff)
+			}
+			if diff := cmp.Diff([]interface{}{user}, args); diff != "" {
+				return nil, errors.Errorf("-want args, +got args:\n%s", diff)
+			}
+			return privileges, nil
+		}
+	}
+
+	cases := map[string]struct {
+		db   pgwire.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedGrantUpToDate": {
+			db: &pgwire.MockDB{
+				MockExists:  exists,
+				MockStrings: showGrants("INSERT", "SELECT"),
+			},
+			mg: cockroachGrant(),
+			want: want{
+				mg:          cockroachGrant(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObservedGrantNeedsUpdate": {
+			db: &pgwire.MockDB{
+				MockExists:  exists,
+				MockStrings: showGrants("SELECT"),
+			},
+			mg: cockroachGrant(),
+			want: want{
+				mg:          cockroachGrant(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ObservedNoPrivileges": {
+			db: &pgwire.MockDB{
+				MockExists:  exists,
+				MockStrings: showGrants(),
+			},
+			mg: cockroachGrant(),
+			want: want{
+				mg:          cockroachGrant(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ObservedDatabaseDoesNotExist": {
+			db: &pgwire.MockDB{
+				MockExists: func(_ context.Context, _ string, _ ...interface{}) (bool, error) {
+					return false, nil
+				},
+			},
+			mg: cockroachGrant(),
+			want: want{
+				mg:          cockroachGrant(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToSelectDatabase": {
+			db: &pgwire.MockDB{
+				MockExists: func(_ context.Context, _ string, _ ...interface{}) (bool, error) {
+					return false, errorBoom
+				},
+			},
+			mg: cockroachGrant(),
+			want: want{
+				mg:  cockroachGrant(),
+				err: errors.Wrap(errorBoom, errSelectCockroachDatabase),
+			},
+		},
+		"FailedToShowGrants": {
+			db: &pgwire.MockDB{
+				MockExists: exists,
+				MockStrings: func(_ context.Context, _ string, args ...interface{}) ([]string, error) {
+					return nil, errorBoom
+				},
+			},
+			mg: cockroachGrant(),
+			want: want{
+				mg:  cockroachGrant(),
+				err: errors.Wrap(errorBoom, errShowCockroachGrants),
+			},
+		},
+		"NotCockroachGrant": {
+			mg: &grantStrange{},
+			want: want{
+				mg:  &grantStrange{},
+				err: errors.New(errNotCockroachGrant),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"GrantedPrivileges": {
+			mg: cockroachGrant(),
+			want: want{
+				mg:   cockroachGrant(withConditions(xpv1.Creating())),
+				stmt: `GRANT SELECT, INSERT ON DATABASE "cool-database" TO "cool-user"`,
+			},
+		},
+		"FailedToGrantPrivileges": {
+			err: errorBoom,
+			mg:  cockroachGrant(),
+			want: want{
+				mg:   cockroachGrant(withConditions(xpv1.Creating())),
+				stmt: `GRANT SELECT, INSERT ON DATABASE "cool-database" TO "cool-user"`,
+				err:  errors.Wrap(errorBoom, errCreateCockroachGrant),
+			},
+		},
+		"NotCockroachGrant": {
+			mg: &grantStrange{},
+			want: want{
+				mg:  &grantStrange{},
+				err: errors.New(errNotCockroachGrant),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Create(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"UpdatedPrivileges": {
+			mg: cockroachGrant(),
+			want: want{
+				stmt: `BEGIN; REVOKE ALL ON DATABASE "cool-database" FROM "cool-user"; GRANT SELECT, INSERT ON DATABASE "cool-database" TO "cool-user"; COMMIT`,
+			},
+		},
+		"FailedToUpdatePrivileges": {
+			err: errorBoom,
+			mg:  cockroachGrant(),
+			want: want{
+				stmt: `BEGIN; REVOKE ALL ON DATABASE "cool-database" FROM "cool-user"; GRANT SELECT, INSERT ON DATABASE "cool-database" TO "cool-user"; COMMIT`,
+				err:  errors.Wrap(errorBoom, errUpdateCockroachGrant),
+			},
+		},
+		"NotCockroachGrant": {
+			mg: &grantStrange{},
+			want: want{
+				err: errors.New(errNotCockroachGrant),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Update(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"RevokedPrivileges": {
+			mg: cockroachGrant(),
+			want: want{
+				mg:   cockroachGrant(withConditions(xpv1.Deleting())),
+				stmt: `REVOKE ALL ON DATABASE "cool-database" FROM "cool-user"`,
+			},
+		},
+		"FailedToRevokePrivileges": {
+			err: errorBoom,
+			mg:  cockroachGrant(),
+			want: want{
+				mg:   cockroachGrant(withConditions(xpv1.Deleting())),
+				stmt: `REVOKE ALL ON DATABASE "cool-database" FROM "cool-user"`,
+				err:  errors.Wrap(errorBoom, errRevokeCockroachGrant),
+			},
+		},
+		"NotCockroachGrant": {
+			mg: &grantStrange{},
+			want: want{
+				mg:  &grantStrange{},
+				err: errors.New(errNotCockroachGrant),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Delete(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPrivilegesEqual(t *testing.T) {
+	cases := map[string]struct {
+		want []v1alpha1.CockroachPrivilege
+		got  []string
+		eq   bool
+	}{
+		"Equal":         {want: []v1alpha1.CockroachPrivilege{"SELECT", "INSERT"}, got: []string{"INSERT", "SELECT"}, eq: true},
+		"LowerCase":     {want: []v1alpha1.CockroachPrivilege{"ALL"}, got: []string{"all"}, eq: true},
+		"Missing":       {want: []v1alpha1.CockroachPrivilege{"SELECT", "INSERT"}, got: []string{"SELECT"}, eq: false},
+		"Extra":         {want: []v1alpha1.CockroachPrivilege{"SELECT"}, got: []string{"SELECT", "DELETE"}, eq: false},
+		"SameCountDiff": {want: []v1alpha1.CockroachPrivilege{"SELECT"}, got: []string{"DELETE"}, eq: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.eq, privilegesEqual(tc.want, tc.got)); diff != "" {
+				t.Errorf("privilegesEqual(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect             = "cannot connect to referenced CockroachCluster"
+	errNotCockroachUser    = "managed resource is not a Cockroach user"
+	errSelectCockroachUser = "cannot select Cockroach user"
+	errCreateCockroachUser = "cannot create Cockroach user"
+	errDropCockroachUser   = "cannot drop Cockroach user"
+)
+
+// Setup creates a new CockroachUser Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CockroachUserKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CockroachUser{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CockroachUserGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	u, ok := mg.(*v1alpha1.CockroachUser)
+	if !ok {
+		return nil, errors.New(errNotCockroachUser)
+	}
+
+	conn, err := cockroach.GetConnection(ctx, c.client, u.Spec.CockroachUserParameters.CockroachClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn)), conn: conn}, nil
+}
+
+type external struct {
+	db   pgwire.DB
+	conn pgwire.Connection
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	u, ok := mg.(*v1alpha1.CockroachUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCockroachUser)
+	}
+
+	name := u.Spec.CockroachUserParameters.Name
+
+	exists, err := e.db.Exists(ctx, "SELECT 1 FROM pg_roles WHERE rolname = $1", name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectCockroachUser)
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	u.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: pgwire.UserConnectionDetails(e.conn, name),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	u, ok := mg.(*v1alpha1.CockroachUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCockroachUser)
+	}
+
+	u.Status.SetConditions(xpv1.Creating())
+
+	// Rook v1.1 only initializes insecure CockroachDB clusters, which do not
+	// support password authentication, so no password is set.
+	name := u.Spec.CockroachUserParameters.Name
+	if err := e.db.Exec(ctx, "CREATE USER "+pgwire.QuoteIdentifier(name)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCockroachUser)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: pgwire.UserConnectionDetails(e.conn, name)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	u, ok := mg.(*v1alpha1.CockroachUser)
+	if !ok {
+		return errors.New(errNotCockroachUser)
+	}
+
+	u.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, "DROP USER IF EXISTS "+pgwire.QuoteIdentifier(u.Spec.CockroachUserParameters.Name))
+	return errors.Wrap(err, errDropCockroachUser)
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+const (
+	name    = "cool-name"
+	user    = "cool-user"
+	cluster = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+var conn = pgwire.Connection{Host: "cool-host", Port: "26257", User: "root", SSLMode: pgwire.SSLModeDisable}
+
+type userStrange struct {
+	resource.Managed
+}
+
+type cockroachUserModifier func(*v1alpha1.CockroachUser)
+
+func withConditions(c ...xpv1.Condition) cockroachUserModifier {
+	return func(i *v1alpha1.CockroachUser) { i.Status.SetConditions(c...) }
+}
+
+func cockroachUser(im ...cockroachUserModifier) *v1alpha1.CockroachUser {
+	i := &v1alpha1.CockroachUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CockroachUserSpec{
+			CockroachUserParameters: v1alpha1.CockroachUserParameters{
+				Name:                      user,
+				CockroachClusterReference: v1alpha1.CockroachClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		db   pgwire.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedUserAvailable": {
+			db: &pgwire.MockDB{
+				MockExists: func(_ context.Context, _ string, args ...interface{}) (bool, error) {
+					return args[0] == user, nil
+				},
+			},
+			mg: cockroachUser(),
+			want: want{
+				mg: cockroachUser(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-host"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("26257"),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(user),
+					},
+				},
+			},
+		},
+		"ObservedUserDoesNotExist": {
+			db: &pgwire.MockDB{
+				MockExists: func(_ context.Context, _ string, _ ...interface{}) (bool, error) {
+					return false, nil
+				},
+			},
+			mg: cockroachUser(),
+			want: want{
+				mg:          cockroachUser(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToSelectUser": {
+			db: &pgwire.MockDB{
+				MockExists: func(_ context.Context, _ string, _ ...interface{}) (bool, error) {
+					return false, errorBoom
+				},
+			},
+			mg: cockroachUser(),
+			want: want{
+				mg:  cockroachUser(),
+				err: errors.Wrap(errorBoom, errSelectCockroachUser),
+			},
+		},
+		"NotCockroachUser": {
+			mg: &userStrange{},
+			want: want{
+				mg:  &userStrange{},
+				err: errors.New(errNotCockroachUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db, conn: conn}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg       resource.Managed
+		stmt     string
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"CreatedUser": {
+			mg: cockroachUser(),
+			want: want{
+				mg:       cockroachUser(withConditions(xpv1.Creating())),
+				stmt:     `CREATE USER "cool-user"`,
+				creation: managed.ExternalCreation{ConnectionDetails: pgwire.UserConnectionDetails(conn, "cool-user")},
+			},
+		},
+		"FailedToCreateUser": {
+			err: errorBoom,
+			mg:  cockroachUser(),
+			want: want{
+				mg:   cockroachUser(withConditions(xpv1.Creating())),
+				stmt: `CREATE USER "cool-user"`,
+				err:  errors.Wrap(errorBoom, errCreateCockroachUser),
+			},
+		},
+		"NotCockroachUser": {
+			mg: &userStrange{},
+			want: want{
+				mg:  &userStrange{},
+				err: errors.New(errNotCockroachUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{conn: conn, db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			got, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Create(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creation, got); diff != "" {
+				t.Errorf("e.Create(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"DroppedUser": {
+			mg: cockroachUser(),
+			want: want{
+				mg:   cockroachUser(withConditions(xpv1.Deleting())),
+				stmt: `DROP USER IF EXISTS "cool-user"`,
+			},
+		},
+		"FailedToDropUser": {
+			err: errorBoom,
+			mg:  cockroachUser(),
+			want: want{
+				mg:   cockroachUser(withConditions(xpv1.Deleting())),
+				stmt: `DROP USER IF EXISTS "cool-user"`,
+				err:  errors.Wrap(errorBoom, errDropCockroachUser),
+			},
+		},
+		"NotCockroachUser": {
+			mg: &userStrange{},
+			want: want{
+				mg:  &userStrange{},
+				err: errors.New(errNotCockroachUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Delete(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zoneconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect                = "cannot connect to referenced CockroachCluster"
+	errNotCockroachZoneConfig = "managed resource is not a Cockroach zone config"
+	errInvalidZone            = "zone must be either a range, a database, or a table within a database"
+	errLeasePreferences       = "lease preferences require CockroachDB v2.1 or later, but Rook deploys CockroachDB v2.0"
+	errShowZoneConfig         = "cannot show zone configuration"
+	errNoZoneConfig           = "no zone configuration shown"
+	errParseZoneConfig        = "cannot parse zone configuration"
+	errMarshalZoneConfig      = "cannot marshal zone configuration"
+	errConfigureZone          = "cannot configure zone"
+	errRemoveZoneConfig       = "cannot remove zone configuration"
+)
+
+// Setup creates a new CockroachZoneConfig Controller and adds it to the
+// Manager with default RBAC. The Manager will set fields on the Controller and
+// start it when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CockroachZoneConfigKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CockroachZoneConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CockroachZoneConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	z, ok := mg.(*v1alpha1.CockroachZoneConfig)
+	if !ok {
+		return nil, errors.New(errNotCockroachZoneConfig)
+	}
+
+	conn, err := cockroach.GetConnection(ctx, c.client, z.Spec.CockroachZoneConfigParameters.CockroachClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn))}, nil
+}
+
+type external struct {
+	db pgwire.DB
+}
+
+// A zoneConfig is the subset of a CockroachDB zone configuration that may be
+// managed by a CockroachZoneConfig. Constraints are decoded loosely, because
+// they may have been configured in a form that this provider does not support.
+type zoneConfig struct {
+	NumReplicas *int32      `json:"num_replicas,omitempty"`
+	GC          *gcPolicy   `json:"gc,omitempty"`
+	Constraints interface{} `json:"constraints,omitempty"`
+}
+
+type gcPolicy struct {
+	TTLSeconds int32 `json:"ttlseconds"`
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	z, ok := mg.(*v1alpha1.CockroachZoneConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCockroachZoneConfig)
+	}
+
+	// Every zone has a configuration, if only the one it inherits, so we
+	// consider it to exist until it has been removed by a delete.
+	if clients.IsRemoved(z) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	params := z.Spec.CockroachZoneConfigParameters
+	t, err := target(params)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// A zone config that cannot be applied may still be deleted.
+	if !meta.WasDeleted(z) {
+		if err := validate(params); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	// Rook v1.1 deploys CockroachDB v2.0, in which zone configuration is
+	// experimental. The zone configuration of a database or table is removed along with
+	// it, which often happens when both are deleted at the same time.
+	configs, err := e.db.Strings(ctx, "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR "+t+"]")
+	if pgwire.IsUndefined(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errShowZoneConfig)
+	}
+	if len(configs) == 0 {
+		return managed.ExternalObservation{}, errors.New(errNoZoneConfig)
+	}
+
+	got := zoneConfig{}
+	if err := yaml.Unmarshal([]byte(configs[0]), &got); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errParseZoneConfig)
+	}
+
+	z.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate(params, got),
+	}, nil
+}
+
+// Create is never called in practice, because every zone has a configuration.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	z, ok := mg.(*v1alpha1.CockroachZoneConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCockroachZoneConfig)
+	}
+
+	return managed.ExternalCreation{}, e.configure(ctx, z.Spec.CockroachZoneConfigParameters)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	z, ok := mg.(*v1alpha1.CockroachZoneConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCockroachZoneConfig)
+	}
+
+	return managed.ExternalUpdate{}, e.configure(ctx, z.Spec.CockroachZoneConfigParameters)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	z, ok := mg.(*v1alpha1.CockroachZoneConfig)
+	if !ok {
+		return errors.New(errNotCockroachZoneConfig)
+	}
+
+	params := z.Spec.CockroachZoneConfigParameters
+	t, err := target(params)
+	if err != nil {
+		return err
+	}
+
+	// The default range must always have a zone configuration, so it is left
+	// as is. Databases and tables revert to the configuration they inherit,
+	// and their configuration is already gone if they no longer exist.
+	if params.Range == "" {
+		if err := e.db.Exec(ctx, "ALTER "+t+" EXPERIMENTAL CONFIGURE ZONE NULL"); err != nil && !pgwire.IsUndefined(err) {
+			return errors.Wrap(err, errRemoveZoneConfig)
+		}
+	}
+
+	clients.SetRemoved(z)
+	return nil
+}
+
+// configure applies the supplied zone configuration. Fields that are omitted
+// keep their current value.
+func (e *external) configure(ctx context.Context, p v1alpha1.CockroachZoneConfigParameters) error {
+	t, err := target(p)
+	if err != nil {
+		return err
+	}
+	if err := validate(p); err != nil {
+		return err
+	}
+
+	y, err := yaml.Marshal(desired(p))
+	if err != nil {
+		return errors.Wrap(err, errMarshalZoneConfig)
+	}
+
+	err = e.db.Exec(ctx, "ALTER "+t+" EXPERIMENTAL CONFIGURE ZONE "+pgwire.QuoteLiteral(string(y)))
+	return errors.Wrap(err, errConfigureZone)
+}
+
+// target returns the zone specifier of the supplied parameters.
+func target(p v1alpha1.CockroachZoneConfigParameters) (string, error) {
+	switch {
+	case p.Range != "" && p.Database == "" && p.Table == "":
+		return "RANGE " + p.Range, nil
+	case p.Range == "" && p.Database != "" && p.Table == "":
+		return "DATABASE " + pgwire.QuoteIdentifier(p.Database), nil
+	case p.Range == "" && p.Database != "" && p.Table != "":
+		return "TABLE " + pgwire.QuoteIdentifier(p.Database) + "." + pgwire.QuoteIdentifier(p.Table), nil
+	default:
+		return "", errors.New(errInvalidZone)
+	}
+}
+
+// validate returns an error if the supplied parameters cannot be applied by
+// the version of CockroachDB that Rook deploys. CockroachDB v2.0 would reject
+// a zone configuration with lease preferences.
+func validate(p v1alpha1.CockroachZoneConfigParameters) error {
+	if len(p.LeasePreferences) > 0 {
+		return errors.New(errLeasePreferences)
+	}
+	return nil
+}
+
+// desired returns the zone configuration described by the supplied parameters.
+func desired(p v1alpha1.CockroachZoneConfigParameters) zoneConfig {
+	z := zoneConfig{NumReplicas: p.NumReplicas}
+	if p.GCTTLSeconds != nil {
+		z.GC = &gcPolicy{TTLSeconds: *p.GCTTLSeconds}
+	}
+	if p.Constraints != nil {
+		z.Constraints = p.Constraints
+	}
+	return z
+}
+
+// upToDate returns true if the supplied observed zone configuration matches
+// the fields of the supplied parameters that are set.
+func upToDate(p v1alpha1.CockroachZoneConfigParameters, got zoneConfig) bool {
+	if p.NumReplicas != nil && (got.NumReplicas == nil || *got.NumReplicas != *p.NumReplicas) {
+		return false
+	}
+	if p.GCTTLSeconds != nil && (got.GC == nil || got.GC.TTLSeconds != *p.GCTTLSeconds) {
+		return false
+	}
+	if p.Constraints != nil && !listEqual(p.Constraints, got.Constraints) {
+		return false
+	}
+	return true
+}
+
+// listEqual returns true if the supplied desired list has the same JSON
+// encoding as the supplied observed list. An observed list that is omitted is
+// considered empty.
+func listEqual(want, got interface{}) bool {
+	if got == nil {
+		got = []interface{}{}
+	}
+	w, err := json.Marshal(want)
+	if err != nil {
+		return false
+	}
+	g, err := json.Marshal(got)
+	if err != nil {
+		return false
+	}
+	return string(w) == string(g)
+}
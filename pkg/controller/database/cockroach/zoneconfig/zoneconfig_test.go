@@ -0,0 +1,442 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zoneconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+const (
+	name     = "cool-name"
+	database = "cool-db"
+	table    = "cool-table"
+	cluster  = "cool-cluster"
+
+	currentYAML = `range_min_bytes: 1048576
+range_max_bytes: 67108864
+gc:
+  ttlseconds: 600
+num_replicas: 5
+constraints: [+region=us-east]
+`
+)
+
+var (
+	errorBoom         = errors.New("boom")
+	errNoSuchDatabase = &pq.Error{Code: "3D000", Message: `database "cool-db" does not exist`}
+	errNoSuchRelation = &pq.Error{Code: "42P01", Message: `relation "cool-db.cool-table" does not exist`}
+)
+
+type zoneStrange struct {
+	resource.Managed
+}
+
+type cockroachZoneConfigModifier func(*v1alpha1.CockroachZoneConfig)
+
+func withConditions(c ...xpv1.Condition) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) { i.Status.SetConditions(c...) }
+}
+
+func withRange(r string) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) {
+		i.Spec.CockroachZoneConfigParameters.Range = r
+		i.Spec.CockroachZoneConfigParameters.Database = ""
+	}
+}
+
+func withTable(t string) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) { i.Spec.CockroachZoneConfigParameters.Table = t }
+}
+
+func withNumReplicas(n int32) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) { i.Spec.CockroachZoneConfigParameters.NumReplicas = &n }
+}
+
+func withConstraints(c []string) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) { i.Spec.CockroachZoneConfigParameters.Constraints = c }
+}
+
+func withLeasePreferences(p [][]string) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) { i.Spec.CockroachZoneConfigParameters.LeasePreferences = p }
+}
+
+func withDeletionTimestamp(t metav1.Time) cockroachZoneConfigModifier {
+	return func(i *v1alpha1.CockroachZoneConfig) { i.SetDeletionTimestamp(&t) }
+}
+
+func cockroachZoneConfig(im ...cockroachZoneConfigModifier) *v1alpha1.CockroachZoneConfig {
+	replicas := int32(5)
+	ttl := int32(600)
+	i := &v1alpha1.CockroachZoneConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.CockroachZoneConfigSpec{
+			CockroachZoneConfigParameters: v1alpha1.CockroachZoneConfigParameters{
+				Database:                  database,
+				NumReplicas:               &replicas,
+				GCTTLSeconds:              &ttl,
+				Constraints:               []string{"+region=us-east"},
+				CockroachClusterReference: v1alpha1.CockroachClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		query       string
+		err         error
+	}
+
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		configs []string
+		err     error
+		mg      resource.Managed
+		want    want
+	}{
+		"ObservedZoneConfigUpToDate": {
+			configs: []string{currentYAML},
+			mg:      cockroachZoneConfig(),
+			want: want{
+				mg:          cockroachZoneConfig(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR DATABASE \"cool-db\"]",
+			},
+		},
+		"ObservedZoneConfigDrifted": {
+			configs: []string{currentYAML},
+			mg:      cockroachZoneConfig(withNumReplicas(3)),
+			want: want{
+				mg:          cockroachZoneConfig(withNumReplicas(3), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR DATABASE \"cool-db\"]",
+			},
+		},
+		"ObservedConstraintsOmitted": {
+			configs: []string{"num_replicas: 5\ngc:\n  ttlseconds: 600\n"},
+			mg:      cockroachZoneConfig(withConstraints([]string{})),
+			want: want{
+				mg:          cockroachZoneConfig(withConstraints([]string{}), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR DATABASE \"cool-db\"]",
+			},
+		},
+		"ObservedTableZoneConfig": {
+			configs: []string{currentYAML},
+			mg:      cockroachZoneConfig(withTable(table)),
+			want: want{
+				mg:          cockroachZoneConfig(withTable(table), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR TABLE \"cool-db\".\"cool-table\"]",
+			},
+		},
+		"ObservedZoneConfigRemoved": {
+			mg: cockroachZoneConfig(withDeletionTimestamp(now), withConditions(xpv1.Deleting())),
+			want: want{
+				mg:          cockroachZoneConfig(withDeletionTimestamp(now), withConditions(xpv1.Deleting())),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ObservedDatabaseDoesNotExist": {
+			err: errNoSuchDatabase,
+			mg:  cockroachZoneConfig(withDeletionTimestamp(now)),
+			want: want{
+				mg:          cockroachZoneConfig(withDeletionTimestamp(now)),
+				observation: managed.ExternalObservation{ResourceExists: false},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR DATABASE \"cool-db\"]",
+			},
+		},
+		"ObservedTableDoesNotExist": {
+			err: errNoSuchRelation,
+			mg:  cockroachZoneConfig(withTable(table)),
+			want: want{
+				mg:          cockroachZoneConfig(withTable(table)),
+				observation: managed.ExternalObservation{ResourceExists: false},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR TABLE \"cool-db\".\"cool-table\"]",
+			},
+		},
+		"RejectedLeasePreferences": {
+			// CockroachDB v2.0 does not report lease preferences, so they
+			// could never be observed to be up to date.
+			configs: []string{currentYAML},
+			mg:      cockroachZoneConfig(withLeasePreferences([][]string{{"+zone=us-east-1a"}})),
+			want: want{
+				mg:  cockroachZoneConfig(withLeasePreferences([][]string{{"+zone=us-east-1a"}})),
+				err: errors.New(errLeasePreferences),
+			},
+		},
+		"ObservedDeletedZoneConfigWithLeasePreferences": {
+			configs: []string{currentYAML},
+			mg:      cockroachZoneConfig(withDeletionTimestamp(now), withLeasePreferences([][]string{{"+zone=us-east-1a"}})),
+			want: want{
+				mg: cockroachZoneConfig(withDeletionTimestamp(now), withLeasePreferences([][]string{{"+zone=us-east-1a"}}),
+					withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				query:       "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR DATABASE \"cool-db\"]",
+			},
+		},
+		"InvalidZone": {
+			mg: cockroachZoneConfig(withRange("default"), withTable(table)),
+			want: want{
+				mg:  cockroachZoneConfig(withRange("default"), withTable(table)),
+				err: errors.New(errInvalidZone),
+			},
+		},
+		"NoZoneConfigShown": {
+			mg: cockroachZoneConfig(withRange("default")),
+			want: want{
+				mg:    cockroachZoneConfig(withRange("default")),
+				query: "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR RANGE default]",
+				err:   errors.New(errNoZoneConfig),
+			},
+		},
+		"FailedToShowZoneConfig": {
+			err: errorBoom,
+			mg:  cockroachZoneConfig(),
+			want: want{
+				mg:    cockroachZoneConfig(),
+				query: "SELECT config_yaml FROM [EXPERIMENTAL SHOW ZONE CONFIGURATION FOR DATABASE \"cool-db\"]",
+				err:   errors.Wrap(errorBoom, errShowZoneConfig),
+			},
+		},
+		"NotCockroachZoneConfig": {
+			mg: &zoneStrange{},
+			want: want{
+				mg:  &zoneStrange{},
+				err: errors.New(errNotCockroachZoneConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var query string
+			e := &external{db: &pgwire.MockDB{
+				MockStrings: func(_ context.Context, q string, _ ...interface{}) ([]string, error) {
+					query = q
+					return tc.configs, tc.err
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.query, query); diff != "" {
+				t.Errorf("e.Observe(): -want query, +got query:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		stmts []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"ConfigureZone": {
+			mg: cockroachZoneConfig(),
+			want: want{
+				stmts: []string{"ALTER DATABASE \"cool-db\" EXPERIMENTAL CONFIGURE ZONE 'constraints:\n- +region=us-east\ngc:\n  ttlseconds: 600\nnum_replicas: 5\n'"},
+			},
+		},
+		"ConfigureSomeOfZone": {
+			mg: &v1alpha1.CockroachZoneConfig{Spec: v1alpha1.CockroachZoneConfigSpec{
+				CockroachZoneConfigParameters: v1alpha1.CockroachZoneConfigParameters{Range: "default", Constraints: []string{}},
+			}},
+			want: want{
+				stmts: []string{"ALTER RANGE default EXPERIMENTAL CONFIGURE ZONE 'constraints: []\n'"},
+			},
+		},
+		"RejectedLeasePreferences": {
+			mg: cockroachZoneConfig(withLeasePreferences([][]string{{"+zone=us-east-1a"}})),
+			want: want{
+				err: errors.New(errLeasePreferences),
+			},
+		},
+		"InvalidZone": {
+			mg: cockroachZoneConfig(withRange("")),
+			want: want{
+				err: errors.New(errInvalidZone),
+			},
+		},
+		"FailedToConfigureZone": {
+			err: errorBoom,
+			mg:  cockroachZoneConfig(withRange("default"), withConstraints([]string{}), withNumReplicas(3)),
+			want: want{
+				stmts: []string{"ALTER RANGE default EXPERIMENTAL CONFIGURE ZONE 'constraints: []\ngc:\n  ttlseconds: 600\nnum_replicas: 3\n'"},
+				err:   errors.Wrap(errorBoom, errConfigureZone),
+			},
+		},
+		"NotCockroachZoneConfig": {
+			mg: &zoneStrange{},
+			want: want{
+				err: errors.New(errNotCockroachZoneConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stmts []string
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmts = append(stmts, query)
+					return tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmts, stmts); diff != "" {
+				t.Errorf("e.Update(): -want statements, +got statements:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg    resource.Managed
+		stmts []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"RemoveDatabaseZoneConfig": {
+			mg: cockroachZoneConfig(),
+			want: want{
+				mg:    cockroachZoneConfig(withConditions(xpv1.Deleting())),
+				stmts: []string{"ALTER DATABASE \"cool-db\" EXPERIMENTAL CONFIGURE ZONE NULL"},
+			},
+		},
+		"RemoveTableZoneConfig": {
+			mg: cockroachZoneConfig(withTable(table)),
+			want: want{
+				mg:    cockroachZoneConfig(withTable(table), withConditions(xpv1.Deleting())),
+				stmts: []string{"ALTER TABLE \"cool-db\".\"cool-table\" EXPERIMENTAL CONFIGURE ZONE NULL"},
+			},
+		},
+		"LeaveRangeZoneConfig": {
+			mg: cockroachZoneConfig(withRange("default")),
+			want: want{
+				mg: cockroachZoneConfig(withRange("default"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DatabaseAlreadyDropped": {
+			err: errNoSuchDatabase,
+			mg:  cockroachZoneConfig(),
+			want: want{
+				mg:    cockroachZoneConfig(withConditions(xpv1.Deleting())),
+				stmts: []string{"ALTER DATABASE \"cool-db\" EXPERIMENTAL CONFIGURE ZONE NULL"},
+			},
+		},
+		"TableAlreadyDropped": {
+			err: errNoSuchRelation,
+			mg:  cockroachZoneConfig(withTable(table)),
+			want: want{
+				mg:    cockroachZoneConfig(withTable(table), withConditions(xpv1.Deleting())),
+				stmts: []string{"ALTER TABLE \"cool-db\".\"cool-table\" EXPERIMENTAL CONFIGURE ZONE NULL"},
+			},
+		},
+		"FailedToRemoveZoneConfig": {
+			err: errorBoom,
+			mg:  cockroachZoneConfig(),
+			want: want{
+				mg:    cockroachZoneConfig(),
+				stmts: []string{"ALTER DATABASE \"cool-db\" EXPERIMENTAL CONFIGURE ZONE NULL"},
+				err:   errors.Wrap(errorBoom, errRemoveZoneConfig),
+			},
+		},
+		"NotCockroachZoneConfig": {
+			mg: &zoneStrange{},
+			want: want{
+				mg:  &zoneStrange{},
+				err: errors.New(errNotCockroachZoneConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stmts []string
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmts = append(stmts, query)
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmts, stmts); diff != "" {
+				t.Errorf("e.Delete(): -want statements, +got statements:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
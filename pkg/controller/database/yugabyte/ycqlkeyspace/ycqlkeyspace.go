@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ycqlkeyspace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/cql"
+	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
+)
+
+// Error strings.
+const (
+	errConnect            = "cannot connect to referenced YugabyteCluster"
+	errNotYCQLKeyspace    = "managed resource is not a YCQL keyspace"
+	errSelectYCQLKeyspace = "cannot select YCQL keyspace"
+	errCreateYCQLKeyspace = "cannot create YCQL keyspace"
+	errDropYCQLKeyspace   = "cannot drop YCQL keyspace"
+)
+
+// Setup creates a new YCQLKeyspace Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.YCQLKeyspaceKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.YCQLKeyspace{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.YCQLKeyspaceGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: cql.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(c cql.Connection) cql.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	k, ok := mg.(*v1alpha1.YCQLKeyspace)
+	if !ok {
+		return nil, errors.New(errNotYCQLKeyspace)
+	}
+
+	conn, err := yugabyte.GetYCQLConnection(ctx, c.client, k.Spec.YCQLKeyspaceParameters.YugabyteClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(conn)}, nil
+}
+
+type external struct {
+	db cql.DB
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	k, ok := mg.(*v1alpha1.YCQLKeyspace)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotYCQLKeyspace)
+	}
+
+	o := v1alpha1.YCQLKeyspaceObservation{}
+	query := "SELECT replication, durable_writes FROM system_schema.keyspaces WHERE keyspace_name = ?"
+	err := e.db.Scan(ctx, query, []interface{}{&o.Replication, &o.DurableWrites}, k.Spec.YCQLKeyspaceParameters.Name)
+	if cql.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectYCQLKeyspace)
+	}
+
+	k.Status.AtProvider = o
+	k.Status.SetConditions(xpv1.Available())
+
+	// YugabyteDB reports SimpleStrategy with the replication factor of the
+	// cluster and durable writes for every keyspace, regardless of the
+	// options with which it was created or altered. The options are
+	// therefore not compared, since they could never be brought up to date.
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	k, ok := mg.(*v1alpha1.YCQLKeyspace)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotYCQLKeyspace)
+	}
+
+	k.Status.SetConditions(xpv1.Creating())
+
+	params := k.Spec.YCQLKeyspaceParameters
+	err := e.db.Exec(ctx, "CREATE KEYSPACE "+cql.QuoteIdentifier(params.Name)+" WITH "+options(params))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateYCQLKeyspace)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	k, ok := mg.(*v1alpha1.YCQLKeyspace)
+	if !ok {
+		return errors.New(errNotYCQLKeyspace)
+	}
+
+	k.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, "DROP KEYSPACE IF EXISTS "+cql.QuoteIdentifier(k.Spec.YCQLKeyspaceParameters.Name))
+	return errors.Wrap(err, errDropYCQLKeyspace)
+}
+
+// options returns the options with which the supplied keyspace should be
+// created.
+func options(p v1alpha1.YCQLKeyspaceParameters) string {
+	o := "DURABLE_WRITES = " + strconv.FormatBool(durableWrites(p))
+	if p.Replication == nil {
+		return o
+	}
+
+	r := replicationOptions(p.Replication)
+	keys := make([]string, 0, len(r))
+	for k := range r {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, len(keys))
+	for i, k := range keys {
+		entries[i] = cql.QuoteLiteral(k) + ": " + cql.QuoteLiteral(r[k])
+	}
+	return "REPLICATION = {" + strings.Join(entries, ", ") + "} AND " + o
+}
+
+// replicationOptions returns the supplied replication as a map of CQL
+// replication options.
+func replicationOptions(r *v1alpha1.YCQLReplication) map[string]string {
+	o := map[string]string{"class": string(r.Class)}
+	if r.ReplicationFactor != nil {
+		o["replication_factor"] = strconv.Itoa(int(*r.ReplicationFactor))
+	}
+	for dc, f := range r.DataCenters {
+		o[dc] = strconv.Itoa(int(f))
+	}
+	return o
+}
+
+func durableWrites(p v1alpha1.YCQLKeyspaceParameters) bool {
+	if p.DurableWrites == nil {
+		return true
+	}
+	return *p.DurableWrites
+}
@@ -0,0 +1,398 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ycqlkeyspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/cql"
+	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
+)
+
+const (
+	name     = "cool-name"
+	keyspace = "cool-keyspace"
+	cluster  = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+type keyspaceStrange struct {
+	resource.Managed
+}
+
+type ycqlKeyspaceModifier func(*v1alpha1.YCQLKeyspace)
+
+func withConditions(c ...xpv1.Condition) ycqlKeyspaceModifier {
+	return func(i *v1alpha1.YCQLKeyspace) { i.Status.SetConditions(c...) }
+}
+
+func withReplication(r *v1alpha1.YCQLReplication) ycqlKeyspaceModifier {
+	return func(i *v1alpha1.YCQLKeyspace) { i.Spec.YCQLKeyspaceParameters.Replication = r }
+}
+
+func withDurableWrites(d bool) ycqlKeyspaceModifier {
+	return func(i *v1alpha1.YCQLKeyspace) { i.Spec.YCQLKeyspaceParameters.DurableWrites = &d }
+}
+
+func withObserved(replication map[string]string, durable bool) ycqlKeyspaceModifier {
+	return func(i *v1alpha1.YCQLKeyspace) {
+		i.Status.AtProvider = v1alpha1.YCQLKeyspaceObservation{Replication: replication, DurableWrites: durable}
+	}
+}
+
+func ycqlKeyspace(im ...ycqlKeyspaceModifier) *v1alpha1.YCQLKeyspace {
+	i := &v1alpha1.YCQLKeyspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.YCQLKeyspaceSpec{
+			YCQLKeyspaceParameters: v1alpha1.YCQLKeyspaceParameters{
+				Name:                     keyspace,
+				YugabyteClusterReference: v1alpha1.YugabyteClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func simple(rf int32) *v1alpha1.YCQLReplication {
+	return &v1alpha1.YCQLReplication{Class: v1alpha1.YCQLReplicationSimple, ReplicationFactor: &rf}
+}
+
+// scan returns a MockScan function that scans the supplied replication and
+// durable_writes columns of a keyspace.
+func scan(replication map[string]string, durable bool) func(context.Context, string, []interface{}, ...interface{}) error {
+	return func(_ context.Context, _ string, dest []interface{}, _ ...interface{}) error {
+		*dest[0].(*map[string]string) = replication
+		*dest[1].(*bool) = durable
+		return nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		conn cql.Connection
+		err  error
+	}
+
+	cases := map[string]struct {
+		client client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"Connected": {
+			client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha1.YugabyteCluster:
+						o.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-namespace"}
+					case *corev1.Secret:
+						o.Data = map[string][]byte{
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte("yb-tservers-cool.cool-namespace.svc"),
+							yugabyte.ConnectionSecretYCQLPortKey:      []byte("9042"),
+						}
+					}
+					return nil
+				},
+			},
+			mg: ycqlKeyspace(),
+			want: want{
+				conn: cql.Connection{Host: "yb-tservers-cool.cool-namespace.svc", Port: "9042"},
+			},
+		},
+		"FailedToConnect": {
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorBoom),
+			},
+			mg: ycqlKeyspace(),
+			want: want{
+				err: errors.Wrap(errors.Wrap(errorBoom, "cannot get referenced YugabyteCluster"), errConnect),
+			},
+		},
+		"NotYCQLKeyspace": {
+			mg: &keyspaceStrange{},
+			want: want{
+				err: errors.New(errNotYCQLKeyspace),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn := cql.Connection{}
+			c := &connecter{client: tc.client, newDB: func(cc cql.Connection) cql.DB {
+				conn = cc
+				return &cql.MockDB{}
+			}}
+			_, err := c.Connect(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("c.Connect(): -want connection, +got connection:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("c.Connect(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	reported := map[string]string{"class": "org.apache.cassandra.locator.SimpleStrategy", "replication_factor": "3"}
+	topology := &v1alpha1.YCQLReplication{
+		Class:       v1alpha1.YCQLReplicationNetworkTopology,
+		DataCenters: map[string]int32{"cool-dc": 5},
+	}
+
+	cases := map[string]struct {
+		db   cql.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedKeyspaceAvailable": {
+			db: &cql.MockDB{MockScan: scan(reported, true)},
+			mg: ycqlKeyspace(),
+			want: want{
+				mg:          ycqlKeyspace(withObserved(reported, true), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObservedReplicationNotApplied": {
+			// YugabyteDB reports SimpleStrategy with the replication factor
+			// of the cluster and durable writes for every keyspace.
+			db: &cql.MockDB{MockScan: scan(reported, true)},
+			mg: ycqlKeyspace(withReplication(topology), withDurableWrites(false)),
+			want: want{
+				mg: ycqlKeyspace(withReplication(topology), withDurableWrites(false),
+					withObserved(reported, true), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObservedKeyspaceDoesNotExist": {
+			db: &cql.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error {
+					return gocql.ErrNotFound
+				},
+			},
+			mg: ycqlKeyspace(),
+			want: want{
+				mg:          ycqlKeyspace(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToSelectKeyspace": {
+			db: &cql.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error {
+					return errorBoom
+				},
+			},
+			mg: ycqlKeyspace(),
+			want: want{
+				mg:  ycqlKeyspace(),
+				err: errors.Wrap(errorBoom, errSelectYCQLKeyspace),
+			},
+		},
+		"NotYCQLKeyspace": {
+			mg: &keyspaceStrange{},
+			want: want{
+				mg:  &keyspaceStrange{},
+				err: errors.New(errNotYCQLKeyspace),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"CreatedKeyspace": {
+			mg: ycqlKeyspace(),
+			want: want{
+				mg:   ycqlKeyspace(withConditions(xpv1.Creating())),
+				stmt: `CREATE KEYSPACE "cool-keyspace" WITH DURABLE_WRITES = true`,
+			},
+		},
+		"CreatedKeyspaceWithReplication": {
+			mg: ycqlKeyspace(withReplication(&v1alpha1.YCQLReplication{
+				Class:       v1alpha1.YCQLReplicationNetworkTopology,
+				DataCenters: map[string]int32{"dc-b": 3, "dc-a": 1},
+			})),
+			want: want{
+				mg: ycqlKeyspace(withReplication(&v1alpha1.YCQLReplication{
+					Class:       v1alpha1.YCQLReplicationNetworkTopology,
+					DataCenters: map[string]int32{"dc-b": 3, "dc-a": 1},
+				}), withConditions(xpv1.Creating())),
+				stmt: `CREATE KEYSPACE "cool-keyspace" WITH REPLICATION = {'class': 'NetworkTopologyStrategy', 'dc-a': '1', 'dc-b': '3'} AND DURABLE_WRITES = true`,
+			},
+		},
+		"FailedToCreateKeyspace": {
+			err: errorBoom,
+			mg:  ycqlKeyspace(),
+			want: want{
+				mg:   ycqlKeyspace(withConditions(xpv1.Creating())),
+				stmt: `CREATE KEYSPACE "cool-keyspace" WITH DURABLE_WRITES = true`,
+				err:  errors.Wrap(errorBoom, errCreateYCQLKeyspace),
+			},
+		},
+		"NotYCQLKeyspace": {
+			mg: &keyspaceStrange{},
+			want: want{
+				mg:  &keyspaceStrange{},
+				err: errors.New(errNotYCQLKeyspace),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &cql.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Create(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"DroppedKeyspace": {
+			mg: ycqlKeyspace(),
+			want: want{
+				mg:   ycqlKeyspace(withConditions(xpv1.Deleting())),
+				stmt: `DROP KEYSPACE IF EXISTS "cool-keyspace"`,
+			},
+		},
+		"FailedToDropKeyspace": {
+			err: errorBoom,
+			mg:  ycqlKeyspace(),
+			want: want{
+				mg:   ycqlKeyspace(withConditions(xpv1.Deleting())),
+				stmt: `DROP KEYSPACE IF EXISTS "cool-keyspace"`,
+				err:  errors.Wrap(errorBoom, errDropYCQLKeyspace),
+			},
+		},
+		"NotYCQLKeyspace": {
+			mg: &keyspaceStrange{},
+			want: want{
+				mg:  &keyspaceStrange{},
+				err: errors.New(errNotYCQLKeyspace),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &cql.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Delete(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ycqlrole
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/cql"
+	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
+)
+
+// Error strings.
+const (
+	errConnect              = "cannot connect to referenced YugabyteCluster"
+	errNotYCQLRole          = "managed resource is not a YCQL role"
+	errSelectYCQLRole       = "cannot select YCQL role"
+	errSelectYCQLPermission = "cannot select YCQL role permissions"
+	errCreateYCQLRole       = "cannot create YCQL role"
+	errAlterYCQLRole        = "cannot alter YCQL role"
+	errGrantYCQLPermission  = "cannot grant YCQL permission"
+	errRevokeYCQLPermission = "cannot revoke YCQL permission"
+	errDropYCQLRole         = "cannot drop YCQL role"
+)
+
+// dataResource is the root of the keyspace and table resources recorded in
+// system_auth.role_permissions.
+const dataResource = "data"
+
+// The permissions granted by ALL on a keyspace and on a table.
+var (
+	allKeyspace = []v1alpha1.YCQLPermission{
+		v1alpha1.YCQLPermissionAlter,
+		v1alpha1.YCQLPermissionAuthorize,
+		v1alpha1.YCQLPermissionCreate,
+		v1alpha1.YCQLPermissionDrop,
+		v1alpha1.YCQLPermissionModify,
+		v1alpha1.YCQLPermissionSelect,
+	}
+	allTable = []v1alpha1.YCQLPermission{
+		v1alpha1.YCQLPermissionAlter,
+		v1alpha1.YCQLPermissionAuthorize,
+		v1alpha1.YCQLPermissionDrop,
+		v1alpha1.YCQLPermissionModify,
+		v1alpha1.YCQLPermissionSelect,
+	}
+)
+
+// Setup creates a new YCQLRole Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.YCQLRoleKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.YCQLRole{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.YCQLRoleGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: cql.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(c cql.Connection) cql.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	r, ok := mg.(*v1alpha1.YCQLRole)
+	if !ok {
+		return nil, errors.New(errNotYCQLRole)
+	}
+
+	conn, err := yugabyte.GetYCQLConnection(ctx, c.client, r.Spec.YCQLRoleParameters.YugabyteClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(conn), conn: conn}, nil
+}
+
+type external struct {
+	db   cql.DB
+	conn cql.Connection
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, ok := mg.(*v1alpha1.YCQLRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotYCQLRole)
+	}
+
+	params := r.Spec.YCQLRoleParameters
+
+	observed := privileges{}
+	query := "SELECT is_superuser, can_login FROM system_auth.roles WHERE role = ?"
+	err := e.db.Scan(ctx, query, []interface{}{&observed.superUser, &observed.login}, params.Name)
+	if cql.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectYCQLRole)
+	}
+
+	granted, err := e.permissions(ctx, params.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectYCQLPermission)
+	}
+
+	r.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  observed == desired(params.Privileges) && reflect.DeepEqual(granted, permissions(params.Grants)),
+		ConnectionDetails: cql.UserConnectionDetails(e.conn, params.Name),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, ok := mg.(*v1alpha1.YCQLRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotYCQLRole)
+	}
+
+	r.Status.SetConditions(xpv1.Creating())
+
+	// Rook starts YugabyteDB without YCQL authentication, so a password
+	// would never be checked. None is set, rather than publishing one that
+	// looks like a credential but is not. Permissions are granted by the
+	// update that follows creation.
+	params := r.Spec.YCQLRoleParameters
+	stmt := fmt.Sprintf("CREATE ROLE %s WITH %s", cql.QuoteIdentifier(params.Name), desired(params.Privileges))
+	if err := e.db.Exec(ctx, stmt); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateYCQLRole)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: cql.UserConnectionDetails(e.conn, params.Name)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, ok := mg.(*v1alpha1.YCQLRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotYCQLRole)
+	}
+
+	params := r.Spec.YCQLRoleParameters
+	role := cql.QuoteIdentifier(params.Name)
+
+	if err := e.db.Exec(ctx, fmt.Sprintf("ALTER ROLE %s WITH %s", role, desired(params.Privileges))); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAlterYCQLRole)
+	}
+
+	granted, err := e.permissions(ctx, params.Name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSelectYCQLPermission)
+	}
+	want := permissions(params.Grants)
+
+	for _, p := range sorted(granted) {
+		if want[p] {
+			continue
+		}
+		if err := e.db.Exec(ctx, fmt.Sprintf("REVOKE %s ON %s FROM %s", p.name, p.on(), role)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRevokeYCQLPermission)
+		}
+	}
+
+	for _, p := range sorted(want) {
+		if granted[p] {
+			continue
+		}
+		if err := e.db.Exec(ctx, fmt.Sprintf("GRANT %s ON %s TO %s", p.name, p.on(), role)); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGrantYCQLPermission)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	r, ok := mg.(*v1alpha1.YCQLRole)
+	if !ok {
+		return errors.New(errNotYCQLRole)
+	}
+
+	r.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, "DROP ROLE IF EXISTS "+cql.QuoteIdentifier(r.Spec.YCQLRoleParameters.Name))
+	return errors.Wrap(err, errDropYCQLRole)
+}
+
+// permissions returns the keyspace and table permissions granted to the
+// supplied role. Permissions on other resources are not managed, and are
+// ignored.
+func (e *external) permissions(ctx context.Context, role string) (map[permission]bool, error) {
+	rows, err := e.db.Rows(ctx, "SELECT resource, permissions FROM system_auth.role_permissions WHERE role = ?", role)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := map[permission]bool{}
+	for _, row := range rows {
+		res, _ := row["resource"].(string)
+		names, _ := row["permissions"].([]string)
+
+		parts := strings.Split(res, "/")
+		if parts[0] != dataResource || len(parts) < 2 || len(parts) > 3 {
+			continue
+		}
+		p := permission{keyspace: parts[1]}
+		if len(parts) == 3 {
+			p.table = parts[2]
+		}
+		for _, n := range names {
+			p.name = n
+			granted[p] = true
+		}
+	}
+	return granted, nil
+}
+
+// A permission on a keyspace, or on a table within it.
+type permission struct {
+	keyspace string
+	table    string
+	name     string
+}
+
+// on returns the resource the permission applies to, for use in a GRANT or
+// REVOKE statement.
+func (p permission) on() string {
+	if p.table == "" {
+		return "KEYSPACE " + cql.QuoteIdentifier(p.keyspace)
+	}
+	return "TABLE " + cql.QuoteIdentifier(p.keyspace) + "." + cql.QuoteIdentifier(p.table)
+}
+
+// permissions returns the permissions described by the supplied grants, with
+// ALL expanded to the permissions it grants.
+func permissions(grants []v1alpha1.YCQLGrant) map[permission]bool {
+	out := map[permission]bool{}
+	for _, g := range grants {
+		for _, name := range g.Permissions {
+			names := []v1alpha1.YCQLPermission{name}
+			if name == v1alpha1.YCQLPermissionAll {
+				names = allKeyspace
+				if g.Table != "" {
+					names = allTable
+				}
+			}
+			for _, n := range names {
+				out[permission{keyspace: g.Keyspace, table: g.Table, name: string(n)}] = true
+			}
+		}
+	}
+	return out
+}
+
+// sorted returns the supplied permissions in a stable order.
+func sorted(ps map[permission]bool) []permission {
+	out := make([]permission, 0, len(ps))
+	for p := range ps {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].keyspace != out[j].keyspace {
+			return out[i].keyspace < out[j].keyspace
+		}
+		if out[i].table != out[j].table {
+			return out[i].table < out[j].table
+		}
+		return out[i].name < out[j].name
+	})
+	return out
+}
+
+// privileges are the privileges of a role, as stored in system_auth.roles.
+type privileges struct {
+	superUser bool
+	login     bool
+}
+
+// desired returns the privileges described by the supplied parameters, with
+// defaults applied.
+func desired(p v1alpha1.YCQLRolePrivileges) privileges {
+	return privileges{
+		superUser: boolValue(p.SuperUser, false),
+		login:     boolValue(p.Login, true),
+	}
+}
+
+// String returns the role options that grant or deny these privileges.
+func (p privileges) String() string {
+	return "SUPERUSER = " + strconv.FormatBool(p.superUser) + " AND LOGIN = " + strconv.FormatBool(p.login)
+}
+
+func boolValue(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
@@ -0,0 +1,502 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ycqlrole
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/cql"
+)
+
+const (
+	name     = "cool-name"
+	role     = "cool-role"
+	keyspace = "cool-keyspace"
+	table    = "cool-table"
+	cluster  = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+var conn = cql.Connection{Host: "cool-host", Port: "9042"}
+
+type roleStrange struct {
+	resource.Managed
+}
+
+type ycqlRoleModifier func(*v1alpha1.YCQLRole)
+
+func withConditions(c ...xpv1.Condition) ycqlRoleModifier {
+	return func(i *v1alpha1.YCQLRole) { i.Status.SetConditions(c...) }
+}
+
+func withPrivileges(p v1alpha1.YCQLRolePrivileges) ycqlRoleModifier {
+	return func(i *v1alpha1.YCQLRole) { i.Spec.YCQLRoleParameters.Privileges = p }
+}
+
+func withGrants(g ...v1alpha1.YCQLGrant) ycqlRoleModifier {
+	return func(i *v1alpha1.YCQLRole) { i.Spec.YCQLRoleParameters.Grants = g }
+}
+
+func ycqlRole(im ...ycqlRoleModifier) *v1alpha1.YCQLRole {
+	i := &v1alpha1.YCQLRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.YCQLRoleSpec{
+			YCQLRoleParameters: v1alpha1.YCQLRoleParameters{
+				Name:                     role,
+				YugabyteClusterReference: v1alpha1.YugabyteClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// scan returns a MockScan function that scans the supplied is_superuser and
+// can_login columns of a role.
+func scan(superUser, login bool) func(context.Context, string, []interface{}, ...interface{}) error {
+	return func(_ context.Context, _ string, dest []interface{}, _ ...interface{}) error {
+		*dest[0].(*bool) = superUser
+		*dest[1].(*bool) = login
+		return nil
+	}
+}
+
+// rows returns a MockRows function that returns the supplied permissions of a
+// role, keyed by resource.
+func rows(granted map[string][]string) func(context.Context, string, ...interface{}) ([]map[string]interface{}, error) {
+	return func(_ context.Context, _ string, _ ...interface{}) ([]map[string]interface{}, error) {
+		out := []map[string]interface{}{}
+		for res, p := range granted {
+			out = append(out, map[string]interface{}{"resource": res, "permissions": p})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	details := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-host"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("9042"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(role),
+	}
+	superUser := true
+	selectTable := v1alpha1.YCQLGrant{Keyspace: keyspace, Table: table, Permissions: []v1alpha1.YCQLPermission{v1alpha1.YCQLPermissionSelect}}
+	allKeyspace := v1alpha1.YCQLGrant{Keyspace: keyspace, Permissions: []v1alpha1.YCQLPermission{v1alpha1.YCQLPermissionAll}}
+
+	cases := map[string]struct {
+		db   cql.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedRoleAvailable": {
+			db: &cql.MockDB{
+				MockScan: scan(false, true),
+				MockRows: rows(map[string][]string{"roles/other-role": {"AUTHORIZE"}}),
+			},
+			mg: ycqlRole(),
+			want: want{
+				mg: ycqlRole(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"ObservedGrantsUpToDate": {
+			db: &cql.MockDB{
+				MockScan: scan(false, true),
+				MockRows: rows(map[string][]string{
+					"data/cool-keyspace":            {"ALTER", "AUTHORIZE", "CREATE", "DROP", "MODIFY", "SELECT"},
+					"data/cool-keyspace/cool-table": {"SELECT"},
+				}),
+			},
+			mg: ycqlRole(withGrants(selectTable, allKeyspace)),
+			want: want{
+				mg: ycqlRole(withGrants(selectTable, allKeyspace), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"ObservedGrantsChanged": {
+			db: &cql.MockDB{
+				MockScan: scan(false, true),
+				MockRows: rows(map[string][]string{"data/cool-keyspace/cool-table": {"SELECT", "MODIFY"}}),
+			},
+			mg: ycqlRole(withGrants(selectTable)),
+			want: want{
+				mg: ycqlRole(withGrants(selectTable), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"ObservedPrivilegesChanged": {
+			db: &cql.MockDB{
+				MockScan: scan(false, true),
+				MockRows: rows(nil),
+			},
+			mg: ycqlRole(withPrivileges(v1alpha1.YCQLRolePrivileges{SuperUser: &superUser})),
+			want: want{
+				mg: ycqlRole(withPrivileges(v1alpha1.YCQLRolePrivileges{SuperUser: &superUser}), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"ObservedRoleDoesNotExist": {
+			db: &cql.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error {
+					return gocql.ErrNotFound
+				},
+			},
+			mg: ycqlRole(),
+			want: want{
+				mg:          ycqlRole(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToSelectRole": {
+			db: &cql.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error {
+					return errorBoom
+				},
+			},
+			mg: ycqlRole(),
+			want: want{
+				mg:  ycqlRole(),
+				err: errors.Wrap(errorBoom, errSelectYCQLRole),
+			},
+		},
+		"FailedToSelectPermissions": {
+			db: &cql.MockDB{
+				MockScan: scan(false, true),
+				MockRows: func(_ context.Context, _ string, _ ...interface{}) ([]map[string]interface{}, error) {
+					return nil, errorBoom
+				},
+			},
+			mg: ycqlRole(),
+			want: want{
+				mg:  ycqlRole(),
+				err: errors.Wrap(errorBoom, errSelectYCQLPermission),
+			},
+		},
+		"NotYCQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				mg:  &roleStrange{},
+				err: errors.New(errNotYCQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db, conn: conn}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg       resource.Managed
+		stmt     string
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	superUser := true
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"CreatedRole": {
+			mg: ycqlRole(),
+			want: want{
+				mg:       ycqlRole(withConditions(xpv1.Creating())),
+				stmt:     `CREATE ROLE "cool-role" WITH SUPERUSER = false AND LOGIN = true`,
+				creation: managed.ExternalCreation{ConnectionDetails: cql.UserConnectionDetails(conn, "cool-role")},
+			},
+		},
+		"CreatedSuperUser": {
+			mg: ycqlRole(withPrivileges(v1alpha1.YCQLRolePrivileges{SuperUser: &superUser})),
+			want: want{
+				mg:       ycqlRole(withPrivileges(v1alpha1.YCQLRolePrivileges{SuperUser: &superUser}), withConditions(xpv1.Creating())),
+				stmt:     `CREATE ROLE "cool-role" WITH SUPERUSER = true AND LOGIN = true`,
+				creation: managed.ExternalCreation{ConnectionDetails: cql.UserConnectionDetails(conn, "cool-role")},
+			},
+		},
+		"FailedToCreateRole": {
+			err: errorBoom,
+			mg:  ycqlRole(),
+			want: want{
+				mg:   ycqlRole(withConditions(xpv1.Creating())),
+				stmt: `CREATE ROLE "cool-role" WITH SUPERUSER = false AND LOGIN = true`,
+				err:  errors.Wrap(errorBoom, errCreateYCQLRole),
+			},
+		},
+		"NotYCQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				mg:  &roleStrange{},
+				err: errors.New(errNotYCQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{conn: conn, db: &cql.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			got, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Create(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creation, got); diff != "" {
+				t.Errorf("e.Create(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		stmts []string
+		err   error
+	}
+
+	selectTable := v1alpha1.YCQLGrant{Keyspace: keyspace, Table: table, Permissions: []v1alpha1.YCQLPermission{v1alpha1.YCQLPermissionSelect}}
+	createKeyspace := v1alpha1.YCQLGrant{Keyspace: keyspace, Permissions: []v1alpha1.YCQLPermission{v1alpha1.YCQLPermissionCreate}}
+	alter := `ALTER ROLE "cool-role" WITH SUPERUSER = false AND LOGIN = true`
+
+	cases := map[string]struct {
+		rows func(context.Context, string, ...interface{}) ([]map[string]interface{}, error)
+		exec func(stmt string) error
+		mg   resource.Managed
+		want want
+	}{
+		"UpdatedRole": {
+			rows: rows(map[string][]string{"data/cool-keyspace/cool-table": {"SELECT", "MODIFY"}}),
+			mg:   ycqlRole(withGrants(selectTable, createKeyspace)),
+			want: want{
+				stmts: []string{
+					alter,
+					`REVOKE MODIFY ON TABLE "cool-keyspace"."cool-table" FROM "cool-role"`,
+					`GRANT CREATE ON KEYSPACE "cool-keyspace" TO "cool-role"`,
+				},
+			},
+		},
+		"FailedToAlterRole": {
+			exec: func(_ string) error { return errorBoom },
+			mg:   ycqlRole(),
+			want: want{
+				stmts: []string{alter},
+				err:   errors.Wrap(errorBoom, errAlterYCQLRole),
+			},
+		},
+		"FailedToSelectPermissions": {
+			rows: func(_ context.Context, _ string, _ ...interface{}) ([]map[string]interface{}, error) {
+				return nil, errorBoom
+			},
+			mg: ycqlRole(),
+			want: want{
+				stmts: []string{alter},
+				err:   errors.Wrap(errorBoom, errSelectYCQLPermission),
+			},
+		},
+		"FailedToRevokePermission": {
+			rows: rows(map[string][]string{"data/cool-keyspace": {"DROP"}}),
+			exec: func(stmt string) error {
+				if strings.HasPrefix(stmt, "REVOKE") {
+					return errorBoom
+				}
+				return nil
+			},
+			mg: ycqlRole(),
+			want: want{
+				stmts: []string{alter, `REVOKE DROP ON KEYSPACE "cool-keyspace" FROM "cool-role"`},
+				err:   errors.Wrap(errorBoom, errRevokeYCQLPermission),
+			},
+		},
+		"FailedToGrantPermission": {
+			rows: rows(nil),
+			exec: func(stmt string) error {
+				if strings.HasPrefix(stmt, "GRANT") {
+					return errorBoom
+				}
+				return nil
+			},
+			mg: ycqlRole(withGrants(selectTable)),
+			want: want{
+				stmts: []string{alter, `GRANT SELECT ON TABLE "cool-keyspace"."cool-table" TO "cool-role"`},
+				err:   errors.Wrap(errorBoom, errGrantYCQLPermission),
+			},
+		},
+		"NotYCQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				err: errors.New(errNotYCQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var stmts []string
+			e := &external{db: &cql.MockDB{
+				MockExec: func(_ context.Context, stmt string, _ ...interface{}) error {
+					stmts = append(stmts, stmt)
+					if tc.exec != nil {
+						return tc.exec(stmt)
+					}
+					return nil
+				},
+				MockRows: tc.rows,
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmts, stmts); diff != "" {
+				t.Errorf("e.Update(): -want statements, +got statements:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"DroppedRole": {
+			mg: ycqlRole(),
+			want: want{
+				mg:   ycqlRole(withConditions(xpv1.Deleting())),
+				stmt: `DROP ROLE IF EXISTS "cool-role"`,
+			},
+		},
+		"FailedToDropRole": {
+			err: errorBoom,
+			mg:  ycqlRole(),
+			want: want{
+				mg:   ycqlRole(withConditions(xpv1.Deleting())),
+				stmt: `DROP ROLE IF EXISTS "cool-role"`,
+				err:  errors.Wrap(errorBoom, errDropYCQLRole),
+			},
+		},
+		"NotYCQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				mg:  &roleStrange{},
+				err: errors.New(errNotYCQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &cql.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Delete(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
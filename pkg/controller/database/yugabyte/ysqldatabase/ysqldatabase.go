@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ysqldatabase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect            = "cannot connect to referenced YugabyteCluster"
+	errNotYSQLDatabase    = "managed resource is not a YSQL database"
+	errSelectYSQLDatabase = "cannot select YSQL database"
+	errCreateYSQLDatabase = "cannot create YSQL database"
+	errAlterYSQLDatabase  = "cannot alter YSQL database"
+	errDropYSQLDatabase   = "cannot drop YSQL database"
+)
+
+// Setup creates a new YSQLDatabase Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.YSQLDatabaseKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.YSQLDatabase{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.YSQLDatabaseGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	d, ok := mg.(*v1alpha1.YSQLDatabase)
+	if !ok {
+		return nil, errors.New(errNotYSQLDatabase)
+	}
+
+	conn, err := yugabyte.GetYSQLConnection(ctx, c.client, d.Spec.YSQLDatabaseParameters.YugabyteClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn))}, nil
+}
+
+type external struct {
+	db pgwire.DB
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	d, ok := mg.(*v1alpha1.YSQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotYSQLDatabase)
+	}
+
+	params := d.Spec.YSQLDatabaseParameters
+
+	owners, err := e.db.Strings(ctx, "SELECT pg_get_userbyid(datdba) FROM pg_database WHERE datname = $1", params.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectYSQLDatabase)
+	}
+	if len(owners) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	d.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: params.Owner == "" || params.Owner == owners[0],
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	d, ok := mg.(*v1alpha1.YSQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotYSQLDatabase)
+	}
+
+	d.Status.SetConditions(xpv1.Creating())
+
+	params := d.Spec.YSQLDatabaseParameters
+	stmt := "CREATE DATABASE " + pgwire.QuoteIdentifier(params.Name)
+	if params.Owner != "" {
+		stmt += " OWNER " + pgwire.QuoteIdentifier(params.Owner)
+	}
+
+	err := e.db.Exec(ctx, stmt)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateYSQLDatabase)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	d, ok := mg.(*v1alpha1.YSQLDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotYSQLDatabase)
+	}
+
+	// The owner is the only mutable parameter of a database.
+	params := d.Spec.YSQLDatabaseParameters
+	if params.Owner == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	err := e.db.Exec(ctx, "ALTER DATABASE "+pgwire.QuoteIdentifier(params.Name)+" OWNER TO "+pgwire.QuoteIdentifier(params.Owner))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAlterYSQLDatabase)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	d, ok := mg.(*v1alpha1.YSQLDatabase)
+	if !ok {
+		return errors.New(errNotYSQLDatabase)
+	}
+
+	d.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, "DROP DATABASE IF EXISTS "+pgwire.QuoteIdentifier(d.Spec.YSQLDatabaseParameters.Name))
+	return errors.Wrap(err, errDropYSQLDatabase)
+}
@@ -0,0 +1,432 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ysqldatabase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+const (
+	name     = "cool-name"
+	database = "cool-database"
+	owner    = "cool-owner"
+	cluster  = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+type databaseStrange struct {
+	resource.Managed
+}
+
+type ysqlDatabaseModifier func(*v1alpha1.YSQLDatabase)
+
+func withConditions(c ...xpv1.Condition) ysqlDatabaseModifier {
+	return func(i *v1alpha1.YSQLDatabase) { i.Status.SetConditions(c...) }
+}
+
+func withOwner(o string) ysqlDatabaseModifier {
+	return func(i *v1alpha1.YSQLDatabase) { i.Spec.YSQLDatabaseParameters.Owner = o }
+}
+
+func ysqlDatabase(im ...ysqlDatabaseModifier) *v1alpha1.YSQLDatabase {
+	i := &v1alpha1.YSQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.YSQLDatabaseSpec{
+			YSQLDatabaseParameters: v1alpha1.YSQLDatabaseParameters{
+				Name:                     database,
+				YugabyteClusterReference: v1alpha1.YugabyteClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestConnect(t *testing.T) {
+	type want struct {
+		dsn string
+		err error
+	}
+
+	cases := map[string]struct {
+		client client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"Connected": {
+			client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *v1alpha1.YugabyteCluster:
+						o.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-namespace"}
+					case *corev1.Secret:
+						o.Data = map[string][]byte{
+							xpv1.ResourceCredentialsSecretEndpointKey: []byte("yb-tservers-cool.cool-namespace.svc"),
+							xpv1.ResourceCredentialsSecretPortKey:     []byte("5433"),
+							xpv1.ResourceCredentialsSecretUserKey:     []byte("postgres"),
+						}
+					}
+					return nil
+				},
+			},
+			mg: ysqlDatabase(),
+			want: want{
+				dsn: "postgres://postgres@yb-tservers-cool.cool-namespace.svc:5433/?sslmode=disable",
+			},
+		},
+		"FailedToConnect": {
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(errorBoom),
+			},
+			mg: ysqlDatabase(),
+			want: want{
+				err: errors.Wrap(errors.Wrap(errorBoom, "cannot get referenced YugabyteCluster"), errConnect),
+			},
+		},
+		"NotYSQLDatabase": {
+			mg: &databaseStrange{},
+			want: want{
+				err: errors.New(errNotYSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dsn := ""
+			c := &connecter{client: tc.client, newDB: func(d string) pgwire.DB {
+				dsn = d
+				return &pgwire.MockDB{}
+			}}
+			_, err := c.Connect(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.dsn, dsn); diff != "" {
+				t.Errorf("c.Connect(): -want DSN, +got DSN:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("c.Connect(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	owned := func(_ context.Context, _ string, args ...interface{}) ([]string, error) {
+		if args[0] != database {
+			return nil, nil
+		}
+		return []string{owner}, nil
+	}
+
+	cases := map[string]struct {
+		db   pgwire.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedDatabaseAvailable": {
+			db: &pgwire.MockDB{MockStrings: owned},
+			mg: ysqlDatabase(),
+			want: want{
+				mg:          ysqlDatabase(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObservedDatabaseOwnerUpToDate": {
+			db: &pgwire.MockDB{MockStrings: owned},
+			mg: ysqlDatabase(withOwner(owner)),
+			want: want{
+				mg:          ysqlDatabase(withOwner(owner), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ObservedDatabaseOwnerChanged": {
+			db: &pgwire.MockDB{MockStrings: owned},
+			mg: ysqlDatabase(withOwner("new-owner")),
+			want: want{
+				mg:          ysqlDatabase(withOwner("new-owner"), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ObservedDatabaseDoesNotExist": {
+			db: &pgwire.MockDB{
+				MockStrings: func(_ context.Context, _ string, _ ...interface{}) ([]string, error) {
+					return nil, nil
+				},
+			},
+			mg: ysqlDatabase(),
+			want: want{
+				mg:          ysqlDatabase(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToSelectDatabase": {
+			db: &pgwire.MockDB{
+				MockStrings: func(_ context.Context, _ string, _ ...interface{}) ([]string, error) {
+					return nil, errorBoom
+				},
+			},
+			mg: ysqlDatabase(),
+			want: want{
+				mg:  ysqlDatabase(),
+				err: errors.Wrap(errorBoom, errSelectYSQLDatabase),
+			},
+		},
+		"NotYSQLDatabase": {
+			mg: &databaseStrange{},
+			want: want{
+				mg:  &databaseStrange{},
+				err: errors.New(errNotYSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"CreatedDatabase": {
+			mg: ysqlDatabase(),
+			want: want{
+				mg:   ysqlDatabase(withConditions(xpv1.Creating())),
+				stmt: `CREATE DATABASE "cool-database"`,
+			},
+		},
+		"CreatedDatabaseWithOwner": {
+			mg: ysqlDatabase(withOwner(owner)),
+			want: want{
+				mg:   ysqlDatabase(withOwner(owner), withConditions(xpv1.Creating())),
+				stmt: `CREATE DATABASE "cool-database" OWNER "cool-owner"`,
+			},
+		},
+		"FailedToCreateDatabase": {
+			err: errorBoom,
+			mg:  ysqlDatabase(),
+			want: want{
+				mg:   ysqlDatabase(withConditions(xpv1.Creating())),
+				stmt: `CREATE DATABASE "cool-database"`,
+				err:  errors.Wrap(errorBoom, errCreateYSQLDatabase),
+			},
+		},
+		"NotYSQLDatabase": {
+			mg: &databaseStrange{},
+			want: want{
+				mg:  &databaseStrange{},
+				err: errors.New(errNotYSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Create(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"NoOwner": {
+			mg:   ysqlDatabase(),
+			want: want{},
+		},
+		"AlteredOwner": {
+			mg: ysqlDatabase(withOwner(owner)),
+			want: want{
+				stmt: `ALTER DATABASE "cool-database" OWNER TO "cool-owner"`,
+			},
+		},
+		"FailedToAlterOwner": {
+			err: errorBoom,
+			mg:  ysqlDatabase(withOwner(owner)),
+			want: want{
+				stmt: `ALTER DATABASE "cool-database" OWNER TO "cool-owner"`,
+				err:  errors.Wrap(errorBoom, errAlterYSQLDatabase),
+			},
+		},
+		"NotYSQLDatabase": {
+			mg: &databaseStrange{},
+			want: want{
+				err: errors.New(errNotYSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Update(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"DroppedDatabase": {
+			mg: ysqlDatabase(),
+			want: want{
+				mg:   ysqlDatabase(withConditions(xpv1.Deleting())),
+				stmt: `DROP DATABASE IF EXISTS "cool-database"`,
+			},
+		},
+		"FailedToDropDatabase": {
+			err: errorBoom,
+			mg:  ysqlDatabase(),
+			want: want{
+				mg:   ysqlDatabase(withConditions(xpv1.Deleting())),
+				stmt: `DROP DATABASE IF EXISTS "cool-database"`,
+				err:  errors.Wrap(errorBoom, errDropYSQLDatabase),
+			},
+		},
+		"NotYSQLDatabase": {
+			mg: &databaseStrange{},
+			want: want{
+				mg:  &databaseStrange{},
+				err: errors.New(errNotYSQLDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Delete(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
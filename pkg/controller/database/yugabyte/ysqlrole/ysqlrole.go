@@ -0,0 +1,199 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ysqlrole
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+// Error strings.
+const (
+	errConnect        = "cannot connect to referenced YugabyteCluster"
+	errNotYSQLRole    = "managed resource is not a YSQL role"
+	errSelectYSQLRole = "cannot select YSQL role"
+	errCreateYSQLRole = "cannot create YSQL role"
+	errAlterYSQLRole  = "cannot alter YSQL role"
+	errDropYSQLRole   = "cannot drop YSQL role"
+)
+
+// Setup creates a new YSQLRole Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.YSQLRoleKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.YSQLRole{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.YSQLRoleGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newDB: pgwire.New}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+	newDB  func(dsn string) pgwire.DB
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	r, ok := mg.(*v1alpha1.YSQLRole)
+	if !ok {
+		return nil, errors.New(errNotYSQLRole)
+	}
+
+	conn, err := yugabyte.GetYSQLConnection(ctx, c.client, r.Spec.YSQLRoleParameters.YugabyteClusterReference)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnect)
+	}
+
+	return &external{db: c.newDB(pgwire.DSN(conn)), conn: conn}, nil
+}
+
+type external struct {
+	db   pgwire.DB
+	conn pgwire.Connection
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	r, ok := mg.(*v1alpha1.YSQLRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotYSQLRole)
+	}
+
+	params := r.Spec.YSQLRoleParameters
+
+	observed := privileges{}
+	query := "SELECT rolsuper, rolcreatedb, rolcreaterole, rolcanlogin FROM pg_roles WHERE rolname = $1"
+	err := e.db.Scan(ctx, query, []interface{}{&observed.superUser, &observed.createDB, &observed.createRole, &observed.login}, params.Name)
+	if pgwire.IsNoRows(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSelectYSQLRole)
+	}
+
+	r.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  observed == desired(params.Privileges),
+		ConnectionDetails: pgwire.UserConnectionDetails(e.conn, params.Name),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	r, ok := mg.(*v1alpha1.YSQLRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotYSQLRole)
+	}
+
+	r.Status.SetConditions(xpv1.Creating())
+
+	// Rook starts YugabyteDB without YSQL authentication, so a password
+	// would never be checked. None is set, rather than publishing one that
+	// looks like a credential but is not.
+	params := r.Spec.YSQLRoleParameters
+	stmt := fmt.Sprintf("CREATE ROLE %s WITH %s", pgwire.QuoteIdentifier(params.Name), desired(params.Privileges))
+	if err := e.db.Exec(ctx, stmt); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateYSQLRole)
+	}
+
+	return managed.ExternalCreation{ConnectionDetails: pgwire.UserConnectionDetails(e.conn, params.Name)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	r, ok := mg.(*v1alpha1.YSQLRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotYSQLRole)
+	}
+
+	params := r.Spec.YSQLRoleParameters
+	err := e.db.Exec(ctx, fmt.Sprintf("ALTER ROLE %s WITH %s", pgwire.QuoteIdentifier(params.Name), desired(params.Privileges)))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errAlterYSQLRole)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	r, ok := mg.(*v1alpha1.YSQLRole)
+	if !ok {
+		return errors.New(errNotYSQLRole)
+	}
+
+	r.SetConditions(xpv1.Deleting())
+
+	err := e.db.Exec(ctx, "DROP ROLE IF EXISTS "+pgwire.QuoteIdentifier(r.Spec.YSQLRoleParameters.Name))
+	return errors.Wrap(err, errDropYSQLRole)
+}
+
+// privileges are the privileges of a role, as stored in pg_roles.
+type privileges struct {
+	superUser  bool
+	createDB   bool
+	createRole bool
+	login      bool
+}
+
+// desired returns the privileges described by the supplied parameters, with
+// defaults applied.
+func desired(p v1alpha1.YSQLRolePrivileges) privileges {
+	return privileges{
+		superUser:  boolValue(p.SuperUser, false),
+		createDB:   boolValue(p.CreateDB, false),
+		createRole: boolValue(p.CreateRole, false),
+		login:      boolValue(p.Login, true),
+	}
+}
+
+// String returns the role options that grant or deny these privileges.
+func (p privileges) String() string {
+	return strings.Join([]string{
+		option(p.superUser, "SUPERUSER"),
+		option(p.createDB, "CREATEDB"),
+		option(p.createRole, "CREATEROLE"),
+		option(p.login, "LOGIN"),
+	}, " ")
+}
+
+func option(granted bool, name string) string {
+	if granted {
+		return name
+	}
+	return "NO" + name
+}
+
+func boolValue(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ysqlrole
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/pgwire"
+)
+
+const (
+	name    = "cool-name"
+	role    = "cool-role"
+	cluster = "cool-cluster"
+)
+
+var errorBoom = errors.New("boom")
+
+var conn = pgwire.Connection{Host: "cool-host", Port: "5433", User: "postgres", SSLMode: pgwire.SSLModeDisable}
+
+type roleStrange struct {
+	resource.Managed
+}
+
+type ysqlRoleModifier func(*v1alpha1.YSQLRole)
+
+func withConditions(c ...xpv1.Condition) ysqlRoleModifier {
+	return func(i *v1alpha1.YSQLRole) { i.Status.SetConditions(c...) }
+}
+
+func withPrivileges(p v1alpha1.YSQLRolePrivileges) ysqlRoleModifier {
+	return func(i *v1alpha1.YSQLRole) { i.Spec.YSQLRoleParameters.Privileges = p }
+}
+
+func ysqlRole(im ...ysqlRoleModifier) *v1alpha1.YSQLRole {
+	i := &v1alpha1.YSQLRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.YSQLRoleSpec{
+			YSQLRoleParameters: v1alpha1.YSQLRoleParameters{
+				Name:                     role,
+				YugabyteClusterReference: v1alpha1.YugabyteClusterReference{Cluster: cluster},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// scan returns a MockScan function that scans the supplied values of
+// rolsuper, rolcreatedb, rolcreaterole and rolcanlogin.
+func scan(values ...bool) func(context.Context, string, []interface{}, ...interface{}) error {
+	return func(_ context.Context, _ string, dest []interface{}, _ ...interface{}) error {
+		for i, v := range values {
+			*dest[i].(*bool) = v
+		}
+		return nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	details := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-host"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("5433"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(role),
+	}
+	createDB := true
+
+	cases := map[string]struct {
+		db   pgwire.DB
+		mg   resource.Managed
+		want want
+	}{
+		"ObservedRoleAvailable": {
+			db: &pgwire.MockDB{MockScan: scan(false, false, false, true)},
+			mg: ysqlRole(),
+			want: want{
+				mg: ysqlRole(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"ObservedRolePrivilegesChanged": {
+			db: &pgwire.MockDB{MockScan: scan(false, false, false, true)},
+			mg: ysqlRole(withPrivileges(v1alpha1.YSQLRolePrivileges{CreateDB: &createDB})),
+			want: want{
+				mg: ysqlRole(withPrivileges(v1alpha1.YSQLRolePrivileges{CreateDB: &createDB}), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: details,
+				},
+			},
+		},
+		"ObservedRoleDoesNotExist": {
+			db: &pgwire.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error {
+					return sql.ErrNoRows
+				},
+			},
+			mg: ysqlRole(),
+			want: want{
+				mg:          ysqlRole(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToSelectRole": {
+			db: &pgwire.MockDB{
+				MockScan: func(_ context.Context, _ string, _ []interface{}, _ ...interface{}) error {
+					return errorBoom
+				},
+			},
+			mg: ysqlRole(),
+			want: want{
+				mg:  ysqlRole(),
+				err: errors.Wrap(errorBoom, errSelectYSQLRole),
+			},
+		},
+		"NotYSQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				mg:  &roleStrange{},
+				err: errors.New(errNotYSQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{db: tc.db, conn: conn}
+			got, err := e.Observe(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("e.Observe(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg       resource.Managed
+		stmt     string
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	superUser := true
+	login := false
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"CreatedRole": {
+			mg: ysqlRole(),
+			want: want{
+				mg:       ysqlRole(withConditions(xpv1.Creating())),
+				stmt:     `CREATE ROLE "cool-role" WITH NOSUPERUSER NOCREATEDB NOCREATEROLE LOGIN`,
+				creation: managed.ExternalCreation{ConnectionDetails: pgwire.UserConnectionDetails(conn, "cool-role")},
+			},
+		},
+		"CreatedRoleWithPrivileges": {
+			mg: ysqlRole(withPrivileges(v1alpha1.YSQLRolePrivileges{SuperUser: &superUser, Login: &login})),
+			want: want{
+				mg:       ysqlRole(withPrivileges(v1alpha1.YSQLRolePrivileges{SuperUser: &superUser, Login: &login}), withConditions(xpv1.Creating())),
+				stmt:     `CREATE ROLE "cool-role" WITH SUPERUSER NOCREATEDB NOCREATEROLE NOLOGIN`,
+				creation: managed.ExternalCreation{ConnectionDetails: pgwire.UserConnectionDetails(conn, "cool-role")},
+			},
+		},
+		"FailedToCreateRole": {
+			err: errorBoom,
+			mg:  ysqlRole(),
+			want: want{
+				mg:   ysqlRole(withConditions(xpv1.Creating())),
+				stmt: `CREATE ROLE "cool-role" WITH NOSUPERUSER NOCREATEDB NOCREATEROLE LOGIN`,
+				err:  errors.Wrap(errorBoom, errCreateYSQLRole),
+			},
+		},
+		"NotYSQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				mg:  &roleStrange{},
+				err: errors.New(errNotYSQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{conn: conn, db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			got, err := e.Create(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Create(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creation, got); diff != "" {
+				t.Errorf("e.Create(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		stmt string
+		err  error
+	}
+
+	createRole := true
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"AlteredRole": {
+			mg: ysqlRole(withPrivileges(v1alpha1.YSQLRolePrivileges{CreateRole: &createRole})),
+			want: want{
+				stmt: `ALTER ROLE "cool-role" WITH NOSUPERUSER NOCREATEDB CREATEROLE LOGIN`,
+			},
+		},
+		"FailedToAlterRole": {
+			err: errorBoom,
+			mg:  ysqlRole(),
+			want: want{
+				stmt: `ALTER ROLE "cool-role" WITH NOSUPERUSER NOCREATEDB NOCREATEROLE LOGIN`,
+				err:  errors.Wrap(errorBoom, errAlterYSQLRole),
+			},
+		},
+		"NotYSQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				err: errors.New(errNotYSQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Update(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg   resource.Managed
+		stmt string
+		err  error
+	}
+
+	cases := map[string]struct {
+		err  error
+		mg   resource.Managed
+		want want
+	}{
+		"DroppedRole": {
+			mg: ysqlRole(),
+			want: want{
+				mg:   ysqlRole(withConditions(xpv1.Deleting())),
+				stmt: `DROP ROLE IF EXISTS "cool-role"`,
+			},
+		},
+		"FailedToDropRole": {
+			err: errorBoom,
+			mg:  ysqlRole(),
+			want: want{
+				mg:   ysqlRole(withConditions(xpv1.Deleting())),
+				stmt: `DROP ROLE IF EXISTS "cool-role"`,
+				err:  errors.Wrap(errorBoom, errDropYSQLRole),
+			},
+		},
+		"NotYSQLRole": {
+			mg: &roleStrange{},
+			want: want{
+				mg:  &roleStrange{},
+				err: errors.New(errNotYSQLRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stmt := ""
+			e := &external{db: &pgwire.MockDB{
+				MockExec: func(_ context.Context, query string, _ ...interface{}) error {
+					stmt = query
+					return tc.err
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.stmt, stmt); diff != "" {
+				t.Errorf("e.Delete(): -want statement, +got statement:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,9 +19,12 @@ package yugabyte
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/pkg/errors"
 	rookv1alpha1 "github.com/rook/rook/pkg/apis/yugabytedb.rook.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +39,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
 	"github.com/crossplane/provider-rook/pkg/clients"
 	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
 )
@@ -48,6 +52,10 @@ const (
 	errCreateYugabyteCluster = "cannot create Yugabyte cluster in target Kubernetes cluster"
 	errUpdateYugabyteCluster = "cannot update Yugabyte cluster in target Kubernetes cluster"
 	errDeleteYugabyteCluster = "cannot delete Yugabyte cluster in target Kubernetes cluster"
+	errBlacklistTServers     = "cannot blacklist departing Yugabyte tservers"
+	errUnblacklistTServers   = "cannot remove returning Yugabyte tservers from blacklist"
+	errGetDataMove           = "cannot get progress of moving data off blacklisted Yugabyte tservers"
+	errExpandVolumeClaims    = "cannot expand Yugabyte volume claims"
 )
 
 // Setup creates a new YugabyteCluster Controller and adds it to the Manager
@@ -78,12 +86,28 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	metav1.AddToGroupVersion(scheme, rookv1alpha1.SchemeGroupVersion)
 
-	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
-	return &external{client: cl}, errors.Wrap(err, errNewYugabyteClient)
+	scheme.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.PersistentVolumeClaim{})
+	metav1.AddToGroupVersion(scheme, corev1.SchemeGroupVersion)
+	scheme.AddKnownTypes(storagev1.SchemeGroupVersion, &storagev1.StorageClass{})
+	metav1.AddToGroupVersion(scheme, storagev1.SchemeGroupVersion)
+
+	cfg, err := clients.NewRESTConfig(ctx, c.client, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewYugabyteClient)
+	}
+
+	cl, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, errors.Wrap(err, errNewYugabyteClient)
+	}
+
+	ex, err := clients.NewExecutor(cfg)
+	return &external{client: cl, exec: ex}, errors.Wrap(err, errNewYugabyteClient)
 }
 
 type external struct {
 	client client.Client
+	exec   clients.Executor
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -114,7 +138,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	o := managed.ExternalObservation{
 		ResourceExists:    true,
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: yugabyte.ConnectionDetails(c),
 	}
 
 	return o, nil
@@ -150,13 +174,44 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetYugabyteCluster)
 	}
 
+	params := c.Spec.YugabyteClusterParameters
+	if err := yugabyte.ValidateMasterReplicas(external.Spec.Master.Replicas, params.Master.Replicas); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// A volume claim that cannot be expanded, for example because its
+	// requested storage shrank, blocks every other update to the cluster
+	// until the request is reverted.
+	claims, err := e.expandVolumeClaims(ctx, c)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errExpandVolumeClaims)
+	}
+	c.Status.AtProvider.VolumeClaims = claims
+
+	// Tservers that are wanted are never left blacklisted, including those
+	// whose pending removal was reverted before their data had been moved.
+	want, have := params.TServer.Replicas, external.Spec.TServer.Replicas
+	if err := e.unblacklist(ctx, c, yugabyte.TServerAddresses(c, 0, want)); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Tservers are removed by the StatefulSet regardless of the data they
+	// hold, so the cluster is not updated until that data has been moved.
+	if want < have {
+		moved, err := e.moveData(ctx, c, yugabyte.TServerAddresses(c, want, have))
+		if err != nil || !moved {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	if !yugabyte.NeedsUpdate(c, external) {
 		return managed.ExternalUpdate{}, nil
 	}
 
 	update := yugabyte.CrossToRook(c)
+	yugabyte.KeepVolumeClaimTemplates(update, external)
 	update.ResourceVersion = external.ResourceVersion
-	err := e.client.Update(ctx, update)
+	err = e.client.Update(ctx, update)
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateYugabyteCluster)
 }
 
@@ -185,3 +240,88 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	err := e.client.Delete(ctx, external)
 	return errors.Wrap(err, errDeleteYugabyteCluster)
 }
+
+// expandVolumeClaims expands the existing volume claims of the masters and
+// tservers of the supplied cluster to the storage requested by their templates.
+func (e *external) expandVolumeClaims(ctx context.Context, c *v1alpha1.YugabyteCluster) ([]corev1alpha1.VolumeClaimObservation, error) {
+	params := c.Spec.YugabyteClusterParameters
+	masters, err := clients.ExpandVolumeClaims(ctx, e.client, params.Namespace, params.Master.VolumeClaimTemplate,
+		yugabyte.VolumeClaimNames(c, yugabyte.MasterName, params.Master))
+	if err != nil {
+		return nil, err
+	}
+	tservers, err := clients.ExpandVolumeClaims(ctx, e.client, params.Namespace, params.TServer.VolumeClaimTemplate,
+		yugabyte.VolumeClaimNames(c, yugabyte.TServerName, params.TServer))
+	if err != nil {
+		return nil, err
+	}
+	return append(masters, tservers...), nil
+}
+
+// moveData blacklists the supplied departing tservers, and returns true once
+// all of their data has been moved to the remaining tservers.
+func (e *external) moveData(ctx context.Context, c *v1alpha1.YugabyteCluster, departing []string) (bool, error) {
+	ns, pod := c.Spec.YugabyteClusterParameters.Namespace, yugabyte.MasterPod(c)
+
+	add := difference(departing, c.Status.AtProvider.BlacklistedTServers)
+	if len(add) > 0 {
+		if _, err := e.exec.Exec(ctx, ns, pod, yugabyte.ChangeBlacklistCommand(c, yugabyte.BlacklistAdd, add)...); err != nil {
+			return false, errors.Wrap(err, errBlacklistTServers)
+		}
+		c.Status.AtProvider.BlacklistedTServers = append(c.Status.AtProvider.BlacklistedTServers, add...)
+	}
+
+	out, err := e.exec.Exec(ctx, ns, pod, yugabyte.LoadMoveCompletionCommand(c)...)
+	if err != nil {
+		return false, errors.Wrap(err, errGetDataMove)
+	}
+	percent, err := yugabyte.ParseLoadMoveCompletion(out)
+	if err != nil {
+		return false, errors.Wrap(err, errGetDataMove)
+	}
+
+	c.Status.AtProvider.DataMovePercentComplete = strconv.FormatFloat(percent, 'f', 2, 64)
+	return percent >= 100, nil
+}
+
+// unblacklist removes the supplied wanted tservers from the blacklist, if
+// they were blacklisted when they were, or were about to be, removed.
+func (e *external) unblacklist(ctx context.Context, c *v1alpha1.YugabyteCluster, returning []string) error {
+	blacklisted := c.Status.AtProvider.BlacklistedTServers
+	remove := intersection(returning, blacklisted)
+	if len(remove) == 0 {
+		return nil
+	}
+
+	ns, pod := c.Spec.YugabyteClusterParameters.Namespace, yugabyte.MasterPod(c)
+	if _, err := e.exec.Exec(ctx, ns, pod, yugabyte.ChangeBlacklistCommand(c, yugabyte.BlacklistRemove, remove)...); err != nil {
+		return errors.Wrap(err, errUnblacklistTServers)
+	}
+
+	c.Status.AtProvider.BlacklistedTServers = difference(blacklisted, remove)
+	return nil
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	return filter(a, b, false)
+}
+
+// intersection returns the elements of a that are also in b.
+func intersection(a, b []string) []string {
+	return filter(a, b, true)
+}
+
+func filter(a, b []string, keep bool) []string {
+	in := make(map[string]bool, len(b))
+	for _, s := range b {
+		in[s] = true
+	}
+	var out []string
+	for _, s := range a {
+		if in[s] == keep {
+			out = append(out, s)
+		}
+	}
+	return out
+}
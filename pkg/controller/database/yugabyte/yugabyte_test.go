@@ -18,6 +18,7 @@ package yugabyte
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,7 +26,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	rookv1alpha1 "github.com/rook/rook/pkg/apis/yugabytedb.rook.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,6 +41,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-rook/apis/database/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/database/yugabyte"
 )
 
 const (
@@ -64,6 +71,31 @@ func yugabyteWithConditions(c ...xpv1.Condition) yugabyteClusterModifier {
 	return func(i *v1alpha1.YugabyteCluster) { i.Status.SetConditions(c...) }
 }
 
+func yugabyteWithReplicas(master, tserver int32) yugabyteClusterModifier {
+	return func(i *v1alpha1.YugabyteCluster) {
+		i.Spec.YugabyteClusterParameters.Master.Replicas = master
+		i.Spec.YugabyteClusterParameters.TServer.Replicas = tserver
+	}
+}
+
+func yugabyteWithBlacklisted(addrs ...string) yugabyteClusterModifier {
+	return func(i *v1alpha1.YugabyteCluster) { i.Status.AtProvider.BlacklistedTServers = addrs }
+}
+
+func yugabyteWithDataMove(percent string) yugabyteClusterModifier {
+	return func(i *v1alpha1.YugabyteCluster) { i.Status.AtProvider.DataMovePercentComplete = percent }
+}
+
+func yugabyteWithTServerStorage(storage string) yugabyteClusterModifier {
+	return func(i *v1alpha1.YugabyteCluster) {
+		i.Spec.YugabyteClusterParameters.TServer.VolumeClaimTemplate = volumeClaimTemplate(storage)
+	}
+}
+
+func yugabyteWithVolumeClaims(o ...corev1alpha1.VolumeClaimObservation) yugabyteClusterModifier {
+	return func(i *v1alpha1.YugabyteCluster) { i.Status.AtProvider.VolumeClaims = o }
+}
+
 func yugabyteCluster(im ...yugabyteClusterModifier) *v1alpha1.YugabyteCluster {
 	i := &v1alpha1.YugabyteCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -113,6 +145,25 @@ func withMasterReplicas(i int32) rookYugabyteClusterModifier {
 	return func(c *rookv1alpha1.YBCluster) { c.Spec.Master.Replicas = i }
 }
 
+func withTServerReplicas(i int32) rookYugabyteClusterModifier {
+	return func(c *rookv1alpha1.YBCluster) { c.Spec.TServer.Replicas = i }
+}
+
+func withTServerStorage(storage string) rookYugabyteClusterModifier {
+	return func(c *rookv1alpha1.YBCluster) { c.Spec.TServer.VolumeClaimTemplate = volumeClaimTemplate(storage) }
+}
+
+func volumeClaimTemplate(storage string) corev1.PersistentVolumeClaim {
+	return corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "datadir"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: kresource.MustParse(storage)},
+			},
+		},
+	}
+}
+
 func rookYugabyteCluster(im ...rookYugabyteClusterModifier) *rookv1alpha1.YBCluster {
 	i := &rookv1alpha1.YBCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -191,8 +242,13 @@ func TestObserveYugabyte(t *testing.T) {
 				mg: yugabyteCluster(
 					yugabyteWithConditions(xpv1.Available())),
 				observation: managed.ExternalObservation{
-					ResourceExists:    true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("yb-tservers-cool-name.cool-namespace.svc"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("5433"),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte("postgres"),
+						yugabyte.ConnectionSecretYCQLPortKey:      []byte("9042"),
+					},
 				},
 			},
 		},
@@ -321,6 +377,48 @@ func TestCreateYugabyte(t *testing.T) {
 }
 
 func TestUpdateYugabyte(t *testing.T) {
+	departing := "yb-tserver-cool-name-2.yb-tservers-cool-name:9100"
+	getCluster := func(rm ...rookYugabyteClusterModifier) func(context.Context, client.ObjectKey, runtime.Object) error {
+		return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			*obj.(*rookv1alpha1.YBCluster) = *rookYugabyteCluster(rm...)
+			return nil
+		}
+	}
+	// getVolumes returns a MockGet function that gets the supplied cluster,
+	// volume claims that request the supplied storage, and a storage class
+	// that allows volume expansion.
+	getVolumes := func(r *rookv1alpha1.YBCluster, storage string) func(context.Context, client.ObjectKey, runtime.Object) error {
+		return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+			switch o := obj.(type) {
+			case *rookv1alpha1.YBCluster:
+				*o = *r
+			case *corev1.PersistentVolumeClaim:
+				o.SetName(key.Name)
+				sc := "cool-class"
+				o.Spec.StorageClassName = &sc
+				o.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: kresource.MustParse(storage)}
+			case *storagev1.StorageClass:
+				expandable := true
+				o.AllowVolumeExpansion = &expandable
+			}
+			return nil
+		}
+	}
+	// admin returns a MockExec function that answers yb-admin commands,
+	// failing any blacklist change other than the supplied one.
+	admin := func(change, percent string) func(context.Context, string, string, ...string) (string, error) {
+		return func(_ context.Context, _, _ string, command ...string) (string, error) {
+			cmd := strings.Join(command[3:], " ")
+			switch {
+			case cmd == "get_load_move_completion":
+				return "Percent complete = " + percent + "\n", nil
+			case cmd == change:
+				return "", nil
+			}
+			return "", errors.Errorf("unexpected command %q", cmd)
+		}
+	}
+
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
@@ -373,6 +471,136 @@ func TestUpdateYugabyte(t *testing.T) {
 				mg: yugabyteCluster(),
 			},
 		},
+		"RefusedMasterChange": {
+			client: &external{client: &test.MockClient{MockGet: getCluster()}},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithReplicas(2, 3)),
+			},
+			want: want{
+				mg:  yugabyteCluster(yugabyteWithReplicas(2, 3)),
+				err: yugabyte.ValidateMasterReplicas(3, 2),
+			},
+		},
+		"ExpandedVolumeClaims": {
+			client: &external{client: &test.MockClient{
+				MockGet: getVolumes(rookYugabyteCluster(withTServerStorage("1Gi")), "1Gi"),
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					if _, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+						return nil
+					}
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithTServerStorage("2Gi")),
+			},
+			want: want{
+				mg: yugabyteCluster(yugabyteWithTServerStorage("2Gi"), yugabyteWithVolumeClaims(
+					corev1alpha1.VolumeClaimObservation{Name: "datadir-cool-name-yb-tserver-cool-name-0", Requested: "2Gi"},
+					corev1alpha1.VolumeClaimObservation{Name: "datadir-cool-name-yb-tserver-cool-name-1", Requested: "2Gi"},
+					corev1alpha1.VolumeClaimObservation{Name: "datadir-cool-name-yb-tserver-cool-name-2", Requested: "2Gi"},
+				)),
+			},
+		},
+		"RefusedVolumeClaimShrink": {
+			client: &external{client: &test.MockClient{
+				MockGet: getVolumes(rookYugabyteCluster(withTServerStorage("4Gi")), "4Gi"),
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithTServerStorage("2Gi")),
+			},
+			want: want{
+				mg:  yugabyteCluster(yugabyteWithTServerStorage("2Gi")),
+				err: errors.Wrap(errors.New("cannot shrink volume claim datadir-cool-name-yb-tserver-cool-name-0 from 4Gi to 2Gi"), errExpandVolumeClaims),
+			},
+		},
+		"MovingDataOffDepartingTServers": {
+			client: &external{
+				client: &test.MockClient{MockGet: getCluster(), MockUpdate: test.NewMockUpdateFn(errorBoom)},
+				exec:   &clients.MockExecutor{MockExec: admin("change_blacklist ADD "+departing, "50")},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithReplicas(3, 2)),
+			},
+			want: want{
+				mg: yugabyteCluster(yugabyteWithReplicas(3, 2), yugabyteWithBlacklisted(departing), yugabyteWithDataMove("50.00")),
+			},
+		},
+		"MovedDataOffDepartingTServers": {
+			client: &external{
+				client: &test.MockClient{MockGet: getCluster(), MockUpdate: test.NewMockUpdateFn(nil)},
+				exec:   &clients.MockExecutor{MockExec: admin("", "100")},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithReplicas(3, 2), yugabyteWithBlacklisted(departing), yugabyteWithDataMove("50.00")),
+			},
+			want: want{
+				mg: yugabyteCluster(yugabyteWithReplicas(3, 2), yugabyteWithBlacklisted(departing), yugabyteWithDataMove("100.00")),
+			},
+		},
+		"FailedToBlacklistTServers": {
+			client: &external{
+				client: &test.MockClient{MockGet: getCluster()},
+				exec: &clients.MockExecutor{MockExec: func(_ context.Context, _, _ string, _ ...string) (string, error) {
+					return "", errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithReplicas(3, 2)),
+			},
+			want: want{
+				mg:  yugabyteCluster(yugabyteWithReplicas(3, 2)),
+				err: errors.Wrap(errorBoom, errBlacklistTServers),
+			},
+		},
+		"ReturningTServersRemovedFromBlacklist": {
+			client: &external{
+				client: &test.MockClient{MockGet: getCluster(withTServerReplicas(2)), MockUpdate: test.NewMockUpdateFn(nil)},
+				exec:   &clients.MockExecutor{MockExec: admin("change_blacklist REMOVE "+departing, "100")},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithBlacklisted(departing), yugabyteWithDataMove("100.00")),
+			},
+			want: want{
+				mg: yugabyteCluster(yugabyteWithBlacklisted(), yugabyteWithDataMove("100.00")),
+			},
+		},
+		"RevertedTServersRemovedFromBlacklist": {
+			client: &external{
+				client: &test.MockClient{MockGet: getCluster(), MockUpdate: test.NewMockUpdateFn(nil)},
+				exec:   &clients.MockExecutor{MockExec: admin("change_blacklist REMOVE "+departing, "50")},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithBlacklisted(departing), yugabyteWithDataMove("50.00")),
+			},
+			want: want{
+				mg: yugabyteCluster(yugabyteWithBlacklisted(), yugabyteWithDataMove("50.00")),
+			},
+		},
+		"FailedToUnblacklistTServers": {
+			client: &external{
+				client: &test.MockClient{MockGet: getCluster()},
+				exec: &clients.MockExecutor{MockExec: func(_ context.Context, _, _ string, _ ...string) (string, error) {
+					return "", errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  yugabyteCluster(yugabyteWithBlacklisted(departing)),
+			},
+			want: want{
+				mg:  yugabyteCluster(yugabyteWithBlacklisted(departing)),
+				err: errors.Wrap(errorBoom, errUnblacklistTServers),
+			},
+		},
 		"NotYugabyteCluster": {
 			client: &external{},
 			args: args{
@@ -22,8 +22,27 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/crossplane/provider-rook/pkg/controller/config"
+	"github.com/crossplane/provider-rook/pkg/controller/database/cassandra"
 	"github.com/crossplane/provider-rook/pkg/controller/database/cockroach"
+	"github.com/crossplane/provider-rook/pkg/controller/database/cockroach/clustersetting"
+	cockroachdatabase "github.com/crossplane/provider-rook/pkg/controller/database/cockroach/database"
+	cockroachgrant "github.com/crossplane/provider-rook/pkg/controller/database/cockroach/grant"
+	cockroachuser "github.com/crossplane/provider-rook/pkg/controller/database/cockroach/user"
+	"github.com/crossplane/provider-rook/pkg/controller/database/cockroach/zoneconfig"
 	"github.com/crossplane/provider-rook/pkg/controller/database/yugabyte"
+	"github.com/crossplane/provider-rook/pkg/controller/database/yugabyte/ycqlkeyspace"
+	"github.com/crossplane/provider-rook/pkg/controller/database/yugabyte/ycqlrole"
+	"github.com/crossplane/provider-rook/pkg/controller/database/yugabyte/ysqldatabase"
+	"github.com/crossplane/provider-rook/pkg/controller/database/yugabyte/ysqlrole"
+	"github.com/crossplane/provider-rook/pkg/controller/storage/csi/storageclass"
+	"github.com/crossplane/provider-rook/pkg/controller/storage/csi/volumesnapshotclass"
+	edgefscluster "github.com/crossplane/provider-rook/pkg/controller/storage/edgefs/cluster"
+	edgefsiscsi "github.com/crossplane/provider-rook/pkg/controller/storage/edgefs/iscsi"
+	edgefsnfs "github.com/crossplane/provider-rook/pkg/controller/storage/edgefs/nfs"
+	edgefss3 "github.com/crossplane/provider-rook/pkg/controller/storage/edgefs/s3"
+	edgefsswift "github.com/crossplane/provider-rook/pkg/controller/storage/edgefs/swift"
+	"github.com/crossplane/provider-rook/pkg/controller/storage/minio"
+	"github.com/crossplane/provider-rook/pkg/controller/storage/nfs"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
@@ -31,8 +50,27 @@ import (
 func Setup(mgr ctrl.Manager, l logging.Logger) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
 		config.Setup,
+		cassandra.Setup,
 		cockroach.Setup,
+		cockroachdatabase.Setup,
+		cockroachuser.Setup,
+		cockroachgrant.Setup,
+		clustersetting.Setup,
+		zoneconfig.Setup,
 		yugabyte.Setup,
+		ysqldatabase.Setup,
+		ysqlrole.Setup,
+		ycqlkeyspace.Setup,
+		ycqlrole.Setup,
+		nfs.Setup,
+		minio.Setup,
+		edgefscluster.Setup,
+		edgefsnfs.Setup,
+		edgefss3.Setup,
+		edgefsswift.Setup,
+		edgefsiscsi.Setup,
+		storageclass.Setup,
+		volumesnapshotclass.Setup,
 	} {
 		if err := setup(mgr, l); err != nil {
 			return err
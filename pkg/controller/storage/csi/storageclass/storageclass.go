@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/csi"
+)
+
+// Error strings.
+const (
+	errNewStorageClassClient = "cannot create new Kubernetes client"
+	errNotCephStorageClass   = "managed resource is not a Ceph storage class"
+	errGetBlockPool          = "cannot get Ceph block pool in target Kubernetes cluster"
+	errGetFilesystem         = "cannot get Ceph filesystem in target Kubernetes cluster"
+	errListCephClusters      = "cannot list Ceph clusters in target Kubernetes cluster"
+	errCephClusterNotReady   = "Ceph cluster in target Kubernetes cluster is not ready"
+	errGetStorageClass       = "cannot get storage class in target Kubernetes cluster"
+	errCreateStorageClass    = "cannot create storage class in target Kubernetes cluster"
+	errReplaceStorageClass   = "cannot replace storage class in target Kubernetes cluster"
+	errDeleteStorageClass    = "cannot delete storage class in target Kubernetes cluster"
+)
+
+// Setup creates a new CephStorageClass Controller and adds it to the Manager
+// with default RBAC. The Manager will set fields on the Controller and start it
+// when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CephStorageClassKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CephStorageClass{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CephStorageClassGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(cephv1.SchemeGroupVersion,
+		&cephv1.CephCluster{},
+		&cephv1.CephClusterList{},
+		&cephv1.CephBlockPool{},
+		&cephv1.CephBlockPoolList{},
+		&cephv1.CephFilesystem{},
+		&cephv1.CephFilesystemList{},
+	)
+	metav1.AddToGroupVersion(scheme, cephv1.SchemeGroupVersion)
+	scheme.AddKnownTypes(storagev1.SchemeGroupVersion,
+		&storagev1.StorageClass{},
+		&storagev1.StorageClassList{},
+	)
+	metav1.AddToGroupVersion(scheme, storagev1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &external{client: cl}, errors.Wrap(err, errNewStorageClassClient)
+}
+
+type external struct {
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha1.CephStorageClass)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCephStorageClass)
+	}
+
+	external := &storagev1.StorageClass{}
+
+	err := e.client.Get(ctx, types.NamespacedName{Name: c.Spec.CephStorageClassParameters.Name}, external)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetStorageClass)
+	}
+
+	// The storage class is of no use without the block pool or filesystem
+	// it provisions volumes from, so we make sure it exists. The source may
+	// already be gone when the storage class is deleted, for example when a
+	// cluster and its storage classes are deleted together.
+	if !meta.WasDeleted(c) {
+		if err := e.getSource(ctx, c.Spec.CephStorageClassParameters.CephCSISource); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	c.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{ResourceExists: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha1.CephStorageClass)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCephStorageClass)
+	}
+
+	c.Status.SetConditions(xpv1.Creating())
+
+	l := &cephv1.CephClusterList{}
+	if err := e.client.List(ctx, l, client.InNamespace(c.Spec.CephStorageClassParameters.ClusterNamespace)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListCephClusters)
+	}
+	if !csi.IsCephClusterReady(l) {
+		return managed.ExternalCreation{}, errors.New(errCephClusterNotReady)
+	}
+
+	err := e.client.Create(ctx, csi.StorageClassCrossToK8s(c))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateStorageClass)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha1.CephStorageClass)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCephStorageClass)
+	}
+
+	external := &storagev1.StorageClass{}
+
+	if err := e.client.Get(ctx, types.NamespacedName{Name: c.Spec.CephStorageClassParameters.Name}, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetStorageClass)
+	}
+
+	if !csi.StorageClassNeedsUpdate(c, external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// Most fields of a storage class are immutable, so we replace it. Volumes
+	// that were provisioned from the old storage class are unaffected.
+	if err := e.client.Delete(ctx, external); resource.IgnoreNotFound(err) != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReplaceStorageClass)
+	}
+	err := e.client.Create(ctx, csi.StorageClassCrossToK8s(c))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errReplaceStorageClass)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha1.CephStorageClass)
+	if !ok {
+		return errors.New(errNotCephStorageClass)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+
+	external := &storagev1.StorageClass{}
+
+	if err := e.client.Get(ctx, types.NamespacedName{Name: c.Spec.CephStorageClassParameters.Name}, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetStorageClass)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrap(err, errDeleteStorageClass)
+}
+
+func (e *external) getSource(ctx context.Context, s v1alpha1.CephCSISource) error {
+	if err := csi.ValidateSource(s); err != nil {
+		return err
+	}
+	if s.Filesystem != nil {
+		key := types.NamespacedName{Name: *s.Filesystem, Namespace: s.ClusterNamespace}
+		return errors.Wrap(e.client.Get(ctx, key, &cephv1.CephFilesystem{}), errGetFilesystem)
+	}
+	key := types.NamespacedName{Name: *s.BlockPool, Namespace: s.ClusterNamespace}
+	return errors.Wrap(e.client.Get(ctx, key, &cephv1.CephBlockPool{}), errGetBlockPool)
+}
@@ -0,0 +1,654 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageclass
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/csi"
+)
+
+const (
+	name             = "cool-name"
+	clusterNamespace = "cool-namespace"
+	filesystem       = "cool-filesystem"
+	uid              = types.UID("definitely-a-uuid")
+)
+
+var errorBoom = errors.New("boom")
+var errorStorageClassNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "storage.k8s.io",
+		Resource: "StorageClass"},
+	"boom")
+
+type storageClassStrange struct {
+	resource.Managed
+}
+
+type cephStorageClassModifier func(*v1alpha1.CephStorageClass)
+
+func withConditions(c ...xpv1.Condition) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.Status.SetConditions(c...) }
+}
+
+func withDeletionTimestamp(t metav1.Time) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.SetDeletionTimestamp(&t) }
+}
+
+func withFSType(t string) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.Spec.CephStorageClassParameters.FSType = t }
+}
+
+func withBlockPool(p *string) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.Spec.CephStorageClassParameters.BlockPool = p }
+}
+
+func withFilesystem(f *string) cephStorageClassModifier {
+	return func(i *v1alpha1.CephStorageClass) { i.Spec.CephStorageClassParameters.Filesystem = f }
+}
+
+func cephStorageClass(im ...cephStorageClassModifier) *v1alpha1.CephStorageClass {
+	p := "cool-pool"
+	i := &v1alpha1.CephStorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.CephStorageClassSpec{
+			CephStorageClassParameters: v1alpha1.CephStorageClassParameters{
+				Name: name,
+				CephCSISource: v1alpha1.CephCSISource{
+					ClusterNamespace: clusterNamespace,
+					BlockPool:        &p,
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func cephClusterList(state cephv1.ClusterState) *cephv1.CephClusterList {
+	return &cephv1.CephClusterList{Items: []cephv1.CephCluster{{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterNamespace, Namespace: clusterNamespace},
+		Status:     cephv1.ClusterStatus{State: state},
+	}}}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserveStorageClass(t *testing.T) {
+	fs := filesystem
+	now := metav1.Now()
+	errorSourceNotFound := kerrors.NewNotFound(schema.GroupResource{Group: "ceph.rook.io", Resource: "CephBlockPool"}, "boom")
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedStorageClassAvailable": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*storagev1.StorageClass); ok {
+						*obj.(*storagev1.StorageClass) = *csi.StorageClassCrossToK8s(cephStorageClass())
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:          cephStorageClass(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObservedStorageClassDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*storagev1.StorageClass); ok {
+						return errorStorageClassNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:          cephStorageClass(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToGetBlockPool": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*cephv1.CephBlockPool); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(),
+				err: errors.Wrap(errorBoom, errGetBlockPool),
+			},
+		},
+		"FailedToGetFilesystem": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*cephv1.CephFilesystem); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(withFilesystem(&fs), withBlockPool(nil)),
+			},
+			want: want{
+				mg:  cephStorageClass(withFilesystem(&fs), withBlockPool(nil)),
+				err: errors.Wrap(errorBoom, errGetFilesystem),
+			},
+		},
+		"ObservedDeletedStorageClassWithoutBlockPool": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*cephv1.CephBlockPool); ok {
+						return errorSourceNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(withDeletionTimestamp(now)),
+			},
+			want: want{
+				mg:          cephStorageClass(withDeletionTimestamp(now), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObservedDeletedStorageClassWithoutFilesystem": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*cephv1.CephFilesystem); ok {
+						return errorSourceNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(withDeletionTimestamp(now), withFilesystem(&fs), withBlockPool(nil)),
+			},
+			want: want{
+				mg:          cephStorageClass(withDeletionTimestamp(now), withFilesystem(&fs), withBlockPool(nil), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InvalidSource": {
+			client: &external{client: &test.MockClient{MockGet: test.NewMockGetFn(nil)}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(withFilesystem(&fs)),
+			},
+			want: want{
+				mg:  cephStorageClass(withFilesystem(&fs)),
+				err: csi.ValidateSource(cephStorageClass(withFilesystem(&fs)).Spec.CephStorageClassParameters.CephCSISource),
+			},
+		},
+		"FailedToGetStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*storagev1.StorageClass); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(),
+				err: errors.Wrap(errorBoom, errGetStorageClass),
+			},
+		},
+		"NotCephStorageClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &storageClassStrange{},
+			},
+			want: want{
+				mg:  &storageClassStrange{},
+				err: errors.New(errNotCephStorageClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateStorageClass(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					*obj.(*cephv1.CephClusterList) = *cephClusterList(cephv1.ClusterStateCreated)
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg: cephStorageClass(withConditions(xpv1.Creating())),
+			},
+		},
+		"CephClusterNotReady": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					*obj.(*cephv1.CephClusterList) = *cephClusterList(cephv1.ClusterStateCreating)
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(withConditions(xpv1.Creating())),
+				err: errors.New(errCephClusterNotReady),
+			},
+		},
+		"FailedToListCephClusters": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errListCephClusters),
+			},
+		},
+		"NotCephStorageClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &storageClassStrange{},
+			},
+			want: want{
+				mg:  &storageClassStrange{},
+				err: errors.New(errNotCephStorageClass),
+			},
+		},
+		"FailedToCreateStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					*obj.(*cephv1.CephClusterList) = *cephClusterList(cephv1.ClusterStateCreated)
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateStorageClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateStorageClass(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ReplacedStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*storagev1.StorageClass) = *csi.StorageClassCrossToK8s(cephStorageClass(withFSType("xfs")))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg: cephStorageClass(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*storagev1.StorageClass) = *csi.StorageClassCrossToK8s(cephStorageClass())
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg: cephStorageClass(),
+			},
+		},
+		"NotCephStorageClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &storageClassStrange{},
+			},
+			want: want{
+				mg:  &storageClassStrange{},
+				err: errors.New(errNotCephStorageClass),
+			},
+		},
+		"FailedToGetStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(),
+				err: errors.Wrap(errorBoom, errGetStorageClass),
+			},
+		},
+		"FailedToDeleteStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*storagev1.StorageClass) = *csi.StorageClassCrossToK8s(cephStorageClass(withFSType("xfs")))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(),
+				err: errors.Wrap(errorBoom, errReplaceStorageClass),
+			},
+		},
+		"FailedToCreateStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*storagev1.StorageClass) = *csi.StorageClassCrossToK8s(cephStorageClass(withFSType("xfs")))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(),
+				err: errors.Wrap(errorBoom, errReplaceStorageClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteStorageClass(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg: cephStorageClass(withConditions(xpv1.Deleting())),
+			},
+		},
+		"StorageClassAlreadyGone": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorStorageClassNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg: cephStorageClass(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotCephStorageClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &storageClassStrange{},
+			},
+			want: want{
+				mg:  &storageClassStrange{},
+				err: errors.New(errNotCephStorageClass),
+			},
+		},
+		"FailedToDeleteStorageClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephStorageClass(),
+			},
+			want: want{
+				mg:  cephStorageClass(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteStorageClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
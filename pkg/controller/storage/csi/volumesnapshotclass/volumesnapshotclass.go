@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshotclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/csi"
+)
+
+// Error strings.
+const (
+	errNewVolumeSnapshotClassClient = "cannot create new Kubernetes client"
+	errNotCephVolumeSnapshotClass   = "managed resource is not a Ceph volume snapshot class"
+	errGetBlockPool                 = "cannot get Ceph block pool in target Kubernetes cluster"
+	errListCephClusters             = "cannot list Ceph clusters in target Kubernetes cluster"
+	errCephClusterNotReady          = "Ceph cluster in target Kubernetes cluster is not ready"
+	errGetVolumeSnapshotClass       = "cannot get volume snapshot class in target Kubernetes cluster"
+	errCreateVolumeSnapshotClass    = "cannot create volume snapshot class in target Kubernetes cluster"
+	errReplaceVolumeSnapshotClass   = "cannot replace volume snapshot class in target Kubernetes cluster"
+	errDeleteVolumeSnapshotClass    = "cannot delete volume snapshot class in target Kubernetes cluster"
+)
+
+// Setup creates a new CephVolumeSnapshotClass Controller and adds it to the
+// Manager with default RBAC. The Manager will set fields on the Controller and
+// start it when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.CephVolumeSnapshotClassKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CephVolumeSnapshotClass{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CephVolumeSnapshotClassGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	// Volume snapshot classes are read and written as unstructured objects,
+	// so only the Rook types need to be known.
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(cephv1.SchemeGroupVersion,
+		&cephv1.CephCluster{},
+		&cephv1.CephClusterList{},
+		&cephv1.CephBlockPool{},
+		&cephv1.CephBlockPoolList{},
+	)
+	metav1.AddToGroupVersion(scheme, cephv1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &external{client: cl}, errors.Wrap(err, errNewVolumeSnapshotClassClient)
+}
+
+type external struct {
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha1.CephVolumeSnapshotClass)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCephVolumeSnapshotClass)
+	}
+
+	params := c.Spec.CephVolumeSnapshotClassParameters
+
+	external := csi.NewVolumeSnapshotClass()
+
+	err := e.client.Get(ctx, types.NamespacedName{Name: params.Name}, external)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetVolumeSnapshotClass)
+	}
+
+	// The volume snapshot class is of no use without the block pool whose
+	// volumes it snapshots, so we make sure it exists. The block pool may
+	// already be gone when the volume snapshot class is deleted.
+	if !meta.WasDeleted(c) {
+		pool := types.NamespacedName{Name: params.BlockPool, Namespace: params.ClusterNamespace}
+		if err := e.client.Get(ctx, pool, &cephv1.CephBlockPool{}); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetBlockPool)
+		}
+	}
+
+	c.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{ResourceExists: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha1.CephVolumeSnapshotClass)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCephVolumeSnapshotClass)
+	}
+
+	c.Status.SetConditions(xpv1.Creating())
+
+	l := &cephv1.CephClusterList{}
+	if err := e.client.List(ctx, l, client.InNamespace(c.Spec.CephVolumeSnapshotClassParameters.ClusterNamespace)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListCephClusters)
+	}
+	if !csi.IsCephClusterReady(l) {
+		return managed.ExternalCreation{}, errors.New(errCephClusterNotReady)
+	}
+
+	err := e.client.Create(ctx, csi.VolumeSnapshotClassCrossToK8s(c))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateVolumeSnapshotClass)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha1.CephVolumeSnapshotClass)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCephVolumeSnapshotClass)
+	}
+
+	external := csi.NewVolumeSnapshotClass()
+
+	if err := e.client.Get(ctx, types.NamespacedName{Name: c.Spec.CephVolumeSnapshotClassParameters.Name}, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetVolumeSnapshotClass)
+	}
+
+	if !csi.VolumeSnapshotClassNeedsUpdate(c, external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// The parameters of a volume snapshot class are immutable, so we replace
+	// it. Existing snapshots are unaffected.
+	if err := e.client.Delete(ctx, external); resource.IgnoreNotFound(err) != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errReplaceVolumeSnapshotClass)
+	}
+	err := e.client.Create(ctx, csi.VolumeSnapshotClassCrossToK8s(c))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errReplaceVolumeSnapshotClass)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha1.CephVolumeSnapshotClass)
+	if !ok {
+		return errors.New(errNotCephVolumeSnapshotClass)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+
+	external := csi.NewVolumeSnapshotClass()
+
+	if err := e.client.Get(ctx, types.NamespacedName{Name: c.Spec.CephVolumeSnapshotClassParameters.Name}, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetVolumeSnapshotClass)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrap(err, errDeleteVolumeSnapshotClass)
+}
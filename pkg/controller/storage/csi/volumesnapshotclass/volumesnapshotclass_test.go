@@ -0,0 +1,595 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshotclass
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/csi"
+)
+
+const (
+	name             = "cool-name"
+	clusterNamespace = "cool-namespace"
+	pool             = "cool-pool"
+	uid              = types.UID("definitely-a-uuid")
+)
+
+var errorBoom = errors.New("boom")
+var errorVolumeSnapshotClassNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "snapshot.storage.k8s.io",
+		Resource: "VolumeSnapshotClass"},
+	"boom")
+
+type volumeSnapshotClassStrange struct {
+	resource.Managed
+}
+
+type cephVolumeSnapshotClassModifier func(*v1alpha1.CephVolumeSnapshotClass)
+
+func withConditions(c ...xpv1.Condition) cephVolumeSnapshotClassModifier {
+	return func(i *v1alpha1.CephVolumeSnapshotClass) { i.Status.SetConditions(c...) }
+}
+
+func withDeletionTimestamp(t metav1.Time) cephVolumeSnapshotClassModifier {
+	return func(i *v1alpha1.CephVolumeSnapshotClass) { i.SetDeletionTimestamp(&t) }
+}
+
+func withBlockPool(p string) cephVolumeSnapshotClassModifier {
+	return func(i *v1alpha1.CephVolumeSnapshotClass) { i.Spec.CephVolumeSnapshotClassParameters.BlockPool = p }
+}
+
+func cephVolumeSnapshotClass(im ...cephVolumeSnapshotClassModifier) *v1alpha1.CephVolumeSnapshotClass {
+	i := &v1alpha1.CephVolumeSnapshotClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.CephVolumeSnapshotClassSpec{
+			CephVolumeSnapshotClassParameters: v1alpha1.CephVolumeSnapshotClassParameters{
+				Name:             name,
+				ClusterNamespace: clusterNamespace,
+				BlockPool:        pool,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func cephClusterList(state cephv1.ClusterState) *cephv1.CephClusterList {
+	return &cephv1.CephClusterList{Items: []cephv1.CephCluster{{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterNamespace, Namespace: clusterNamespace},
+		Status:     cephv1.ClusterStatus{State: state},
+	}}}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserveVolumeSnapshotClass(t *testing.T) {
+	now := metav1.Now()
+	errorBlockPoolNotFound := kerrors.NewNotFound(schema.GroupResource{Group: "ceph.rook.io", Resource: "CephBlockPool"}, pool)
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedVolumeSnapshotClassAvailable": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if u, ok := obj.(*unstructured.Unstructured); ok {
+						*u = *csi.VolumeSnapshotClassCrossToK8s(cephVolumeSnapshotClass())
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:          cephVolumeSnapshotClass(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObservedVolumeSnapshotClassDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*unstructured.Unstructured); ok {
+						return errorVolumeSnapshotClassNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:          cephVolumeSnapshotClass(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToGetBlockPool": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: clusterNamespace, Name: pool}) {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(),
+				err: errors.Wrap(errorBoom, errGetBlockPool),
+			},
+		},
+		"ObservedDeletedVolumeSnapshotClassWithoutBlockPool": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: clusterNamespace, Name: pool}) {
+						return errorBlockPoolNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(withDeletionTimestamp(now)),
+			},
+			want: want{
+				mg:          cephVolumeSnapshotClass(withDeletionTimestamp(now), withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"FailedToGetVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*unstructured.Unstructured); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(),
+				err: errors.Wrap(errorBoom, errGetVolumeSnapshotClass),
+			},
+		},
+		"NotCephVolumeSnapshotClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &volumeSnapshotClassStrange{},
+			},
+			want: want{
+				mg:  &volumeSnapshotClassStrange{},
+				err: errors.New(errNotCephVolumeSnapshotClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateVolumeSnapshotClass(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					*obj.(*cephv1.CephClusterList) = *cephClusterList(cephv1.ClusterStateCreated)
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg: cephVolumeSnapshotClass(withConditions(xpv1.Creating())),
+			},
+		},
+		"CephClusterNotReady": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					*obj.(*cephv1.CephClusterList) = *cephClusterList(cephv1.ClusterStateCreating)
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(withConditions(xpv1.Creating())),
+				err: errors.New(errCephClusterNotReady),
+			},
+		},
+		"FailedToListCephClusters": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errListCephClusters),
+			},
+		},
+		"NotCephVolumeSnapshotClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &volumeSnapshotClassStrange{},
+			},
+			want: want{
+				mg:  &volumeSnapshotClassStrange{},
+				err: errors.New(errNotCephVolumeSnapshotClass),
+			},
+		},
+		"FailedToCreateVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					*obj.(*cephv1.CephClusterList) = *cephClusterList(cephv1.ClusterStateCreated)
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateVolumeSnapshotClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateVolumeSnapshotClass(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ReplacedVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*unstructured.Unstructured) = *csi.VolumeSnapshotClassCrossToK8s(cephVolumeSnapshotClass(withBlockPool("old-pool")))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg: cephVolumeSnapshotClass(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*unstructured.Unstructured) = *csi.VolumeSnapshotClassCrossToK8s(cephVolumeSnapshotClass())
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg: cephVolumeSnapshotClass(),
+			},
+		},
+		"NotCephVolumeSnapshotClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &volumeSnapshotClassStrange{},
+			},
+			want: want{
+				mg:  &volumeSnapshotClassStrange{},
+				err: errors.New(errNotCephVolumeSnapshotClass),
+			},
+		},
+		"FailedToGetVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(),
+				err: errors.Wrap(errorBoom, errGetVolumeSnapshotClass),
+			},
+		},
+		"FailedToDeleteVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*unstructured.Unstructured) = *csi.VolumeSnapshotClassCrossToK8s(cephVolumeSnapshotClass(withBlockPool("old-pool")))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(),
+				err: errors.Wrap(errorBoom, errReplaceVolumeSnapshotClass),
+			},
+		},
+		"FailedToCreateVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*unstructured.Unstructured) = *csi.VolumeSnapshotClassCrossToK8s(cephVolumeSnapshotClass(withBlockPool("old-pool")))
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				},
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(),
+				err: errors.Wrap(errorBoom, errReplaceVolumeSnapshotClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteVolumeSnapshotClass(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg: cephVolumeSnapshotClass(withConditions(xpv1.Deleting())),
+			},
+		},
+		"VolumeSnapshotClassAlreadyGone": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorVolumeSnapshotClassNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg: cephVolumeSnapshotClass(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotCephVolumeSnapshotClass": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &volumeSnapshotClassStrange{},
+			},
+			want: want{
+				mg:  &volumeSnapshotClassStrange{},
+				err: errors.New(errNotCephVolumeSnapshotClass),
+			},
+		},
+		"FailedToDeleteVolumeSnapshotClass": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  cephVolumeSnapshotClass(),
+			},
+			want: want{
+				mg:  cephVolumeSnapshotClass(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteVolumeSnapshotClass),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/edgefs"
+)
+
+// Error strings.
+const (
+	errNewEdgefsClient     = "cannot create new Kubernetes client"
+	errNotEdgefsCluster    = "managed resource is not an EdgeFS cluster"
+	errGetEdgefsCluster    = "cannot get EdgeFS cluster in target Kubernetes cluster"
+	errCreateEdgefsCluster = "cannot create EdgeFS cluster in target Kubernetes cluster"
+	errUpdateEdgefsCluster = "cannot update EdgeFS cluster in target Kubernetes cluster"
+	errDeleteEdgefsCluster = "cannot delete EdgeFS cluster in target Kubernetes cluster"
+)
+
+// Setup creates a new EdgefsCluster Controller and adds it to the Manager
+// with default RBAC. The Manager will set fields on the Controller and start it
+// when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.EdgefsClusterKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.EdgefsCluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EdgefsClusterGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(rookv1.SchemeGroupVersion,
+		&rookv1.Cluster{},
+		&rookv1.ClusterList{},
+	)
+	metav1.AddToGroupVersion(scheme, rookv1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &external{client: cl}, errors.Wrap(err, errNewEdgefsClient)
+}
+
+type external struct {
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha1.EdgefsCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEdgefsCluster)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.EdgefsClusterParameters.Name,
+		Namespace: c.Spec.EdgefsClusterParameters.Namespace,
+	}
+
+	external := &rookv1.Cluster{}
+
+	err := e.client.Get(ctx, key, external)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEdgefsCluster)
+	}
+
+	switch external.Status.State {
+	case rookv1.ClusterStateCreated:
+		c.Status.SetConditions(xpv1.Available())
+	case rookv1.ClusterStateDeleting:
+		c.Status.SetConditions(xpv1.Deleting())
+	case rookv1.ClusterStateError:
+		c.Status.SetConditions(xpv1.Unavailable())
+	default:
+		c.Status.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{ResourceExists: true}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha1.EdgefsCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEdgefsCluster)
+	}
+
+	c.Status.SetConditions(xpv1.Creating())
+
+	err := e.client.Create(ctx, edgefs.ClusterCrossToRook(c))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateEdgefsCluster)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha1.EdgefsCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEdgefsCluster)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.EdgefsClusterParameters.Name,
+		Namespace: c.Spec.EdgefsClusterParameters.Namespace,
+	}
+
+	external := &rookv1.Cluster{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetEdgefsCluster)
+	}
+
+	if !edgefs.ClusterNeedsUpdate(c, external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	update := edgefs.ClusterCrossToRook(c)
+	update.ResourceVersion = external.ResourceVersion
+	err := e.client.Update(ctx, update)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEdgefsCluster)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha1.EdgefsCluster)
+	if !ok {
+		return errors.New(errNotEdgefsCluster)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+
+	key := types.NamespacedName{
+		Name:      c.Spec.EdgefsClusterParameters.Name,
+		Namespace: c.Spec.EdgefsClusterParameters.Namespace,
+	}
+
+	external := &rookv1.Cluster{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetEdgefsCluster)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrap(err, errDeleteEdgefsCluster)
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	rookv1 "github.com/rook/rook/pkg/apis/edgefs.rook.io/v1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+	uid       = types.UID("definitely-a-uuid")
+
+	connectionSecretName = "cool-connection-secret"
+)
+
+var errorBoom = errors.New("boom")
+var errorEdgefsNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "edgefs.rook.io",
+		Resource: "Cluster"},
+	"boom")
+
+type edgefsStrange struct {
+	resource.Managed
+}
+
+type edgefsClusterModifier func(*v1alpha1.EdgefsCluster)
+
+func withConditions(c ...xpv1.Condition) edgefsClusterModifier {
+	return func(i *v1alpha1.EdgefsCluster) { i.Status.SetConditions(c...) }
+}
+
+func edgefsCluster(im ...edgefsClusterModifier) *v1alpha1.EdgefsCluster {
+	i := &v1alpha1.EdgefsCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.EdgefsClusterSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			EdgefsClusterParameters: v1alpha1.EdgefsClusterParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Storage: v1alpha1.EdgefsStorageSpec{
+					UseAllNodes: true,
+				},
+				DataDirHostPath: "/var/lib/edgefs",
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookEdgefsClusterModifier func(*rookv1.Cluster)
+
+func withState(s rookv1.ClusterState) rookEdgefsClusterModifier {
+	return func(i *rookv1.Cluster) { i.Status.State = s }
+}
+
+func withDataDirHostPath(p string) rookEdgefsClusterModifier {
+	return func(i *rookv1.Cluster) { i.Spec.DataDirHostPath = p }
+}
+
+func rookEdgefsCluster(im ...rookEdgefsClusterModifier) *rookv1.Cluster {
+	i := &rookv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: rookv1.ClusterSpec{
+			Annotations: rook.AnnotationsSpec{rook.KeyAll: rook.Annotations(map[string]string{"label": "value"})},
+			Storage: rook.StorageScopeSpec{
+				UseAllNodes: true,
+			},
+			DataDirHostPath: "/var/lib/edgefs",
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserveEdgefsCluster(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedClusterAvailable": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*rookv1.Cluster) = *rookEdgefsCluster(withState(rookv1.ClusterStateCreated))
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:          edgefsCluster(withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObservedClusterCreating": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*rookv1.Cluster) = *rookEdgefsCluster(withState(rookv1.ClusterStateCreating))
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:          edgefsCluster(withConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObservedClusterError": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					*obj.(*rookv1.Cluster) = *rookEdgefsCluster(withState(rookv1.ClusterStateError))
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:          edgefsCluster(withConditions(xpv1.Unavailable())),
+				observation: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObservedClusterDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorEdgefsNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:          edgefsCluster(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToGetCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:  edgefsCluster(),
+				err: errors.Wrap(errorBoom, errGetEdgefsCluster),
+			},
+		},
+		"NotEdgefsCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &edgefsStrange{},
+			},
+			want: want{
+				mg:  &edgefsStrange{},
+				err: errors.New(errNotEdgefsCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateEdgefsCluster(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedCluster": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg: edgefsCluster(withConditions(xpv1.Creating())),
+			},
+		},
+		"NotEdgefsCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &edgefsStrange{},
+			},
+			want: want{
+				mg:  &edgefsStrange{},
+				err: errors.New(errNotEdgefsCluster),
+			},
+		},
+		"FailedToCreateCluster": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:  edgefsCluster(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateEdgefsCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateEdgefsCluster(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"UpdatedCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1.Cluster) = *rookEdgefsCluster(withDataDirHostPath("/var/lib/other"))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg: edgefsCluster(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1.Cluster) = *rookEdgefsCluster()
+					}
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg: edgefsCluster(),
+			},
+		},
+		"NotEdgefsCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &edgefsStrange{},
+			},
+			want: want{
+				mg:  &edgefsStrange{},
+				err: errors.New(errNotEdgefsCluster),
+			},
+		},
+		"FailedToGetCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:  edgefsCluster(),
+				err: errors.Wrap(errorBoom, errGetEdgefsCluster),
+			},
+		},
+		"FailedToUpdateCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1.Cluster) = *rookEdgefsCluster(withDataDirHostPath("/var/lib/other"))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:  edgefsCluster(),
+				err: errors.Wrap(errorBoom, errUpdateEdgefsCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteEdgefsCluster(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1.Cluster) = *rookEdgefsCluster()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg: edgefsCluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotEdgefsCluster": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &edgefsStrange{},
+			},
+			want: want{
+				mg:  &edgefsStrange{},
+				err: errors.New(errNotEdgefsCluster),
+			},
+		},
+		"FailedToDeleteCluster": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1.Cluster) = *rookEdgefsCluster()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  edgefsCluster(),
+			},
+			want: want{
+				mg:  edgefsCluster(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteEdgefsCluster),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/minio.rook.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/minio"
+)
+
+// Error strings.
+const (
+	errNewMinioClient         = "cannot create new Kubernetes client"
+	errNotMinioObjectStore    = "managed resource is not a Minio object store"
+	errGetMinioObjectStore    = "cannot get Minio object store in target Kubernetes cluster"
+	errGetMinioCredentials    = "cannot get Minio object store credentials secret in target Kubernetes cluster"
+	errCreateMinioObjectStore = "cannot create Minio object store in target Kubernetes cluster"
+	errUpdateMinioObjectStore = "cannot update Minio object store in target Kubernetes cluster"
+	errDeleteMinioObjectStore = "cannot delete Minio object store in target Kubernetes cluster"
+)
+
+// Setup creates a new MinioObjectStore Controller and adds it to the Manager
+// with default RBAC. The Manager will set fields on the Controller and start it
+// when the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.MinioObjectStoreKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.MinioObjectStore{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MinioObjectStoreGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(rookv1alpha1.SchemeGroupVersion,
+		&rookv1alpha1.ObjectStore{},
+		&rookv1alpha1.ObjectStoreList{},
+	)
+	metav1.AddToGroupVersion(scheme, rookv1alpha1.SchemeGroupVersion)
+	scheme.AddKnownTypes(corev1.SchemeGroupVersion,
+		&corev1.Secret{},
+	)
+	metav1.AddToGroupVersion(scheme, corev1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &external{client: cl}, errors.Wrap(err, errNewMinioClient)
+}
+
+type external struct {
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha1.MinioObjectStore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMinioObjectStore)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.MinioObjectStoreParameters.Name,
+		Namespace: c.Spec.MinioObjectStoreParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.ObjectStore{}
+
+	err := e.client.Get(ctx, key, external)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMinioObjectStore)
+	}
+
+	// The Rook Minio operator reads the access and secret keys of the object
+	// store from the referenced credentials secret, so we publish the same
+	// keys as connection details.
+	ref := c.Spec.MinioObjectStoreParameters.Credentials
+	creds := &corev1.Secret{}
+	if err := e.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, creds); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetMinioCredentials)
+	}
+
+	// If we are able to get the resource ObjectStore instance, we will
+	// consider it available. If a status is added to the ObjectStore CRD in
+	// the future we should check it to set conditions.
+	c.Status.SetConditions(xpv1.Available())
+
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: minio.ConnectionDetails(c, creds),
+	}
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha1.MinioObjectStore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMinioObjectStore)
+	}
+
+	c.Status.SetConditions(xpv1.Creating())
+
+	err := e.client.Create(ctx, minio.CrossToRook(c))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateMinioObjectStore)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha1.MinioObjectStore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMinioObjectStore)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.MinioObjectStoreParameters.Name,
+		Namespace: c.Spec.MinioObjectStoreParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.ObjectStore{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetMinioObjectStore)
+	}
+
+	if !minio.NeedsUpdate(c, external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	update := minio.CrossToRook(c)
+	update.ResourceVersion = external.ResourceVersion
+	err := e.client.Update(ctx, update)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateMinioObjectStore)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha1.MinioObjectStore)
+	if !ok {
+		return errors.New(errNotMinioObjectStore)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+
+	key := types.NamespacedName{
+		Name:      c.Spec.MinioObjectStoreParameters.Name,
+		Namespace: c.Spec.MinioObjectStoreParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.ObjectStore{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetMinioObjectStore)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrap(err, errDeleteMinioObjectStore)
+}
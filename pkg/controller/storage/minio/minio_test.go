@@ -0,0 +1,544 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package minio
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/minio.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+	uid       = types.UID("definitely-a-uuid")
+
+	connectionSecretName = "cool-connection-secret"
+	credentialsName      = "cool-credentials"
+)
+
+var errorBoom = errors.New("boom")
+var errorMinioNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "minio.rook.io",
+		Resource: "ObjectStore"},
+	"boom")
+
+type minioStrange struct {
+	resource.Managed
+}
+
+type minioObjectStoreModifier func(*v1alpha1.MinioObjectStore)
+
+func withConditions(c ...xpv1.Condition) minioObjectStoreModifier {
+	return func(i *v1alpha1.MinioObjectStore) { i.Status.SetConditions(c...) }
+}
+
+func minioObjectStore(im ...minioObjectStoreModifier) *v1alpha1.MinioObjectStore {
+	i := &v1alpha1.MinioObjectStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.MinioObjectStoreSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			MinioObjectStoreParameters: v1alpha1.MinioObjectStoreParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Storage: corev1alpha1.StorageScopeSpec{
+					NodeCount: 4,
+				},
+				Credentials: corev1.SecretReference{Name: credentialsName, Namespace: namespace},
+				StorageSize: "10G",
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookMinioObjectStoreModifier func(*rookv1alpha1.ObjectStore)
+
+func withNodeCount(c int) rookMinioObjectStoreModifier {
+	return func(i *rookv1alpha1.ObjectStore) { i.Spec.Storage.NodeCount = c }
+}
+
+func rookMinioObjectStore(im ...rookMinioObjectStoreModifier) *rookv1alpha1.ObjectStore {
+	i := &rookv1alpha1.ObjectStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: rookv1alpha1.ObjectStoreSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Storage: rook.StorageScopeSpec{
+				NodeCount: 4,
+			},
+			Credentials: corev1.SecretReference{Name: credentialsName, Namespace: namespace},
+			StorageSize: "10G",
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserveMinio(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedObjectStoreAvailable": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *rookv1alpha1.ObjectStore:
+						*o = *rookMinioObjectStore()
+					case *corev1.Secret:
+						o.Data = map[string][]byte{
+							"username": []byte("cool-access-key"),
+							"password": []byte("cool-secret-key"),
+						}
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg: minioObjectStore(
+					withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("cool-name.cool-namespace.svc.cluster.local"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("9000"),
+						"accessKey":                               []byte("cool-access-key"),
+						"secretKey":                               []byte("cool-secret-key"),
+					},
+				},
+			},
+		},
+		"FailedToGetCredentials": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*corev1.Secret); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:  minioObjectStore(),
+				err: errors.Wrap(errorBoom, errGetMinioCredentials),
+			},
+		},
+		"ObservedObjectStoreDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorMinioNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:          minioObjectStore(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FailedToGetObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:  minioObjectStore(),
+				err: errors.Wrap(errorBoom, errGetMinioObjectStore),
+			},
+		},
+		"NotMinioObjectStore": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &minioStrange{},
+			},
+			want: want{
+				mg:  &minioStrange{},
+				err: errors.New(errNotMinioObjectStore),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateMinio(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg: minioObjectStore(withConditions(xpv1.Creating())),
+			},
+		},
+		"NotMinioObjectStore": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &minioStrange{},
+			},
+			want: want{
+				mg:  &minioStrange{},
+				err: errors.New(errNotMinioObjectStore),
+			},
+		},
+		"FailedToCreateObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:  minioObjectStore(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateMinioObjectStore),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateMinio(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"UpdatedObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.ObjectStore) = *rookMinioObjectStore(withNodeCount(6))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg: minioObjectStore(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.ObjectStore) = *rookMinioObjectStore()
+					}
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg: minioObjectStore(),
+			},
+		},
+		"NotMinioObjectStore": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &minioStrange{},
+			},
+			want: want{
+				mg:  &minioStrange{},
+				err: errors.New(errNotMinioObjectStore),
+			},
+		},
+		"FailedToGetObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:  minioObjectStore(),
+				err: errors.Wrap(errorBoom, errGetMinioObjectStore),
+			},
+		},
+		"FailedToUpdateObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.ObjectStore) = *rookMinioObjectStore(withNodeCount(6))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:  minioObjectStore(),
+				err: errors.Wrap(errorBoom, errUpdateMinioObjectStore),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteMinio(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.ObjectStore) = *rookMinioObjectStore()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg: minioObjectStore(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotMinioObjectStore": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &minioStrange{},
+			},
+			want: want{
+				mg:  &minioStrange{},
+				err: errors.New(errNotMinioObjectStore),
+			},
+		},
+		"FailedToDeleteObjectStore": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.ObjectStore) = *rookMinioObjectStore()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  minioObjectStore(),
+			},
+			want: want{
+				mg:  minioObjectStore(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteMinioObjectStore),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
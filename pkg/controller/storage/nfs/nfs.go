@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/nfs.rook.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	"github.com/crossplane/provider-rook/pkg/clients"
+	"github.com/crossplane/provider-rook/pkg/clients/storage/nfs"
+)
+
+// Error strings.
+const (
+	errNewNFSClient    = "cannot create new Kubernetes client"
+	errNotNFSServer    = "managed resource is not an NFS server"
+	errGetNFSServer    = "cannot get NFS server in target Kubernetes cluster"
+	errGetNFSService   = "cannot get NFS server service in target Kubernetes cluster"
+	errCreateNFSServer = "cannot create NFS server in target Kubernetes cluster"
+	errUpdateNFSServer = "cannot update NFS server in target Kubernetes cluster"
+	errDeleteNFSServer = "cannot delete NFS server in target Kubernetes cluster"
+)
+
+// Setup creates a new NFSServer Controller and adds it to the Manager with
+// default RBAC. The Manager will set fields on the Controller and start it when
+// the Manager is Started.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(fmt.Sprintf("%s.%s", v1alpha1.NFSServerKind, v1alpha1.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.NFSServer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NFSServerGroupVersionKind),
+			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connecter struct {
+	client client.Client
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(rookv1alpha1.SchemeGroupVersion,
+		&rookv1alpha1.NFSServer{},
+		&rookv1alpha1.NFSServerList{},
+	)
+	metav1.AddToGroupVersion(scheme, rookv1alpha1.SchemeGroupVersion)
+	scheme.AddKnownTypes(corev1.SchemeGroupVersion,
+		&corev1.Service{},
+	)
+	metav1.AddToGroupVersion(scheme, corev1.SchemeGroupVersion)
+
+	cl, err := clients.NewClient(ctx, c.client, mg, scheme)
+	return &external{client: cl}, errors.Wrap(err, errNewNFSClient)
+}
+
+type external struct {
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	c, ok := mg.(*v1alpha1.NFSServer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNFSServer)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.NFSServerParameters.Name,
+		Namespace: c.Spec.NFSServerParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.NFSServer{}
+
+	err := e.client.Get(ctx, key, external)
+	if kerrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetNFSServer)
+	}
+
+	o := managed.ExternalObservation{
+		ResourceExists:    true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}
+
+	// The Rook NFS operator exposes each NFS server through a Service of
+	// the same name. The NFSServer CRD has no status, so we consider the
+	// server available once its Service has been assigned a cluster IP.
+	svc := &corev1.Service{}
+	err = e.client.Get(ctx, key, svc)
+	if resource.IgnoreNotFound(err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetNFSService)
+	}
+	if err != nil || svc.Spec.ClusterIP == "" {
+		c.Status.SetConditions(xpv1.Creating())
+		return o, nil
+	}
+
+	c.Status.SetConditions(xpv1.Available())
+	o.ConnectionDetails = nfs.ConnectionDetails(svc)
+
+	return o, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, ok := mg.(*v1alpha1.NFSServer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNFSServer)
+	}
+
+	c.Status.SetConditions(xpv1.Creating())
+
+	err := e.client.Create(ctx, nfs.CrossToRook(c))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNFSServer)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	c, ok := mg.(*v1alpha1.NFSServer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNFSServer)
+	}
+
+	key := types.NamespacedName{
+		Name:      c.Spec.NFSServerParameters.Name,
+		Namespace: c.Spec.NFSServerParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.NFSServer{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNFSServer)
+	}
+
+	if !nfs.NeedsUpdate(c, external) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	update := nfs.CrossToRook(c)
+	update.ResourceVersion = external.ResourceVersion
+	err := e.client.Update(ctx, update)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNFSServer)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	c, ok := mg.(*v1alpha1.NFSServer)
+	if !ok {
+		return errors.New(errNotNFSServer)
+	}
+
+	c.SetConditions(xpv1.Deleting())
+
+	key := types.NamespacedName{
+		Name:      c.Spec.NFSServerParameters.Name,
+		Namespace: c.Spec.NFSServerParameters.Namespace,
+	}
+
+	external := &rookv1alpha1.NFSServer{}
+
+	if err := e.client.Get(ctx, key, external); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, errGetNFSServer)
+	}
+
+	err := e.client.Delete(ctx, external)
+	return errors.Wrap(err, errDeleteNFSServer)
+}
@@ -0,0 +1,558 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	rookv1alpha1 "github.com/rook/rook/pkg/apis/nfs.rook.io/v1alpha1"
+	rook "github.com/rook/rook/pkg/apis/rook.io/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-rook/apis/storage/v1alpha1"
+	corev1alpha1 "github.com/crossplane/provider-rook/apis/v1alpha1"
+)
+
+const (
+	name      = "cool-name"
+	namespace = "cool-namespace"
+	uid       = types.UID("definitely-a-uuid")
+
+	connectionSecretName = "cool-connection-secret"
+
+	clusterIP = "10.0.0.10"
+)
+
+var errorBoom = errors.New("boom")
+var errorNFSNotFound = kerrors.NewNotFound(
+	schema.GroupResource{
+		Group:    "nfs.rook.io",
+		Resource: "NFSServer"},
+	"boom")
+
+type nfsStrange struct {
+	resource.Managed
+}
+
+type nfsServerModifier func(*v1alpha1.NFSServer)
+
+func withConditions(c ...xpv1.Condition) nfsServerModifier {
+	return func(i *v1alpha1.NFSServer) { i.Status.SetConditions(c...) }
+}
+
+func nfsServer(im ...nfsServerModifier) *v1alpha1.NFSServer {
+	i := &v1alpha1.NFSServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.NFSServerSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				WriteConnectionSecretToReference: &xpv1.SecretReference{Name: connectionSecretName},
+			},
+			NFSServerParameters: v1alpha1.NFSServerParameters{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: corev1alpha1.Annotations(map[string]string{"label": "value"}),
+				Replicas:    1,
+				Exports: []v1alpha1.ExportsSpec{{
+					Name: "share1",
+					Server: v1alpha1.ServerSpec{
+						AccessMode: "ReadWrite",
+						Squash:     "none",
+					},
+					PersistentVolumeClaim: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nfs-default-claim"},
+				}},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+type rookNFSServerModifier func(*rookv1alpha1.NFSServer)
+
+func withReplicas(r int) rookNFSServerModifier {
+	return func(i *rookv1alpha1.NFSServer) { i.Spec.Replicas = r }
+}
+
+func rookNFSServer(im ...rookNFSServerModifier) *rookv1alpha1.NFSServer {
+	i := &rookv1alpha1.NFSServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			UID:        uid,
+			Finalizers: []string{},
+		},
+		Spec: rookv1alpha1.NFSServerSpec{
+			Annotations: rook.Annotations(map[string]string{"label": "value"}),
+			Replicas:    1,
+			Exports: []rookv1alpha1.ExportsSpec{{
+				Name: "share1",
+				Server: rookv1alpha1.ServerSpec{
+					AccessMode: "ReadWrite",
+					Squash:     "none",
+				},
+				PersistentVolumeClaim: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "nfs-default-claim"},
+			}},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connecter{}
+
+func TestObserveNFS(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg          resource.Managed
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"ObservedServerAvailable": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *rookv1alpha1.NFSServer:
+						*o = *rookNFSServer()
+					case *corev1.Service:
+						o.Spec.ClusterIP = clusterIP
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg: nfsServer(
+					withConditions(xpv1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(clusterIP),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("2049"),
+					},
+				},
+			},
+		},
+		"ObservedServiceDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch o := obj.(type) {
+					case *rookv1alpha1.NFSServer:
+						*o = *rookNFSServer()
+					case *corev1.Service:
+						return errorNFSNotFound
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg: nfsServer(
+					withConditions(xpv1.Creating())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"FailedToGetService": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if _, ok := obj.(*corev1.Service); ok {
+						return errorBoom
+					}
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg:  nfsServer(),
+				err: errors.Wrap(errorBoom, errGetNFSService),
+			},
+		},
+		"ObservedServerDoesNotExist": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorNFSNotFound
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg:          nfsServer(),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotNFSServer": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &nfsStrange{},
+			},
+			want: want{
+				mg:  &nfsStrange{},
+				err: errors.New(errNotNFSServer),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Observe(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.observation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Observe(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateNFS(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		creation managed.ExternalCreation
+		err      error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"CreatedServer": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg: nfsServer(withConditions(xpv1.Creating())),
+			},
+		},
+		"NotNFSServer": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &nfsStrange{},
+			},
+			want: want{
+				mg:  &nfsStrange{},
+				err: errors.New(errNotNFSServer),
+			},
+		},
+		"FailedToCreateServer": {
+			client: &external{client: &test.MockClient{
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg:  nfsServer(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateNFSServer),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Create(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.creation, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Create(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateNFS(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg     resource.Managed
+		update managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"UpdatedServer": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.NFSServer) = *rookNFSServer(withReplicas(2))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg: nfsServer(),
+			},
+		},
+		"UpdatedNotRequired": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.NFSServer) = *rookNFSServer()
+					}
+					return nil
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg: nfsServer(),
+			},
+		},
+		"NotNFSServer": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &nfsStrange{},
+			},
+			want: want{
+				mg:  &nfsStrange{},
+				err: errors.New(errNotNFSServer),
+			},
+		},
+		"FailedToGetServer": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg:  nfsServer(),
+				err: errors.Wrap(errorBoom, errGetNFSServer),
+			},
+		},
+		"FailedToUpdateServer": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.NFSServer) = *rookNFSServer(withReplicas(2))
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+					return errorBoom
+				},
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg:  nfsServer(),
+				err: errors.Wrap(errorBoom, errUpdateNFSServer),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.client.Update(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.update, got, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want, +got:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Update(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteNFS(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		args   args
+		want   want
+	}{
+		"DeletedServer": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.NFSServer) = *rookNFSServer()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return nil
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg: nfsServer(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotNFSServer": {
+			client: &external{},
+			args: args{
+				ctx: context.Background(),
+				mg:  &nfsStrange{},
+			},
+			want: want{
+				mg:  &nfsStrange{},
+				err: errors.New(errNotNFSServer),
+			},
+		},
+		"FailedToDeleteServer": {
+			client: &external{client: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					if key == (client.ObjectKey{Namespace: namespace, Name: name}) {
+						*obj.(*rookv1alpha1.NFSServer) = *rookNFSServer()
+					}
+					return nil
+				},
+				MockDelete: func(_ context.Context, obj runtime.Object, _ ...client.DeleteOption) error {
+					return errorBoom
+				}},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  nfsServer(),
+			},
+			want: want{
+				mg:  nfsServer(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteNFSServer),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.client.Delete(tc.args.ctx, tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("tc.client.Delete(): -want error, +got error:\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("resource.Managed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}